@@ -9,14 +9,18 @@ import (
 	"github.com/stjudewashere/seonaut/internal/config"
 	"github.com/stjudewashere/seonaut/internal/crawler"
 	"github.com/stjudewashere/seonaut/internal/datastore"
+	"github.com/stjudewashere/seonaut/internal/duplicates"
 	"github.com/stjudewashere/seonaut/internal/export"
+	"github.com/stjudewashere/seonaut/internal/hreflang"
 	"github.com/stjudewashere/seonaut/internal/http"
 	"github.com/stjudewashere/seonaut/internal/issue"
+	"github.com/stjudewashere/seonaut/internal/keywords"
 	"github.com/stjudewashere/seonaut/internal/project"
 	"github.com/stjudewashere/seonaut/internal/projectview"
 	"github.com/stjudewashere/seonaut/internal/pubsub"
 	"github.com/stjudewashere/seonaut/internal/report"
 	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
 	"github.com/stjudewashere/seonaut/internal/report_manager/reporters"
 	"github.com/stjudewashere/seonaut/internal/report_manager/sql_reporters"
 	"github.com/stjudewashere/seonaut/internal/user"
@@ -51,6 +55,12 @@ func main() {
 		log.Fatalf("Error running migrations: %v\n", err)
 	}
 
+	// Sync the registered issue types with the database.
+	err = ds.SyncIssueTypes(reporter_errors.GetAllIssueTypes())
+	if err != nil {
+		log.Fatalf("Error syncing issue types: %v\n", err)
+	}
+
 	// Build services.
 	broker := pubsub.New()
 	cache := cache.New(config.Cache)
@@ -73,6 +83,10 @@ func main() {
 		reportManager.AddMultipageReporter(r)
 	}
 
+	for _, r := range sqlReporters.GetThresholdReporters() {
+		reportManager.AddThresholdMultipageReporter(r)
+	}
+
 	// Start HTTP server.
 	services := &http.Services{
 		UserService:        user.NewService(ds),
@@ -84,6 +98,9 @@ func main() {
 		ProjectViewService: projectview.NewService(ds),
 		PubSubBroker:       broker,
 		ExportService:      export.NewExporter(ds),
+		DuplicatesService:  duplicates.NewService(ds),
+		KeywordsService:    keywords.NewService(ds),
+		HreflangService:    hreflang.NewService(ds),
 	}
 
 	server := http.NewApp(
@@ -3,7 +3,9 @@ module github.com/stjudewashere/seonaut
 go 1.16
 
 require (
+	github.com/andybalholm/cascadia v1.3.2
 	github.com/antchfx/htmlquery v1.3.0
+	github.com/antchfx/xpath v1.2.4
 	github.com/go-redis/cache/v8 v8.4.4
 	github.com/go-redis/redis/v8 v8.11.5
 	github.com/go-sql-driver/mysql v1.7.1
@@ -25,7 +27,6 @@ require (
 
 require (
 	github.com/Azure/azure-storage-blob-go v0.14.0 // indirect
-	github.com/antchfx/xpath v1.2.4 // indirect
 	github.com/apache/arrow/go/arrow v0.0.0-20211013220434-5962184e7a30 // indirect
 	github.com/cespare/xxhash/v2 v2.2.0 // indirect
 	github.com/klauspost/compress v1.16.7 // indirect
@@ -36,6 +36,7 @@ func TestLoadConfig(t *testing.T) {
 		{config.DB.Pass, "root"},
 		{config.DB.Name, "test"},
 		{config.Crawler.Agent, "testing"},
+		{config.Crawler.Bot, "testbot"},
 	}
 
 	for _, v := range m {
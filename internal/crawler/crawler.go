@@ -19,11 +19,16 @@ type Options struct {
 	FollowNofollow  bool
 	IncludeNoindex  bool
 	UserAgent       string
+	Bot             string
 	CrawlSitemap    bool
 	AllowSubdomains bool
 	BasicAuth       bool
 	AuthUser        string
 	AuthPass        string
+
+	CheckExternalLinks bool
+	Extractors         []*html_parser.Extractor
+	SearchRules        []*html_parser.SearchRule
 }
 
 type Crawler struct {
@@ -38,10 +43,13 @@ type Crawler struct {
 	robotstxtExists bool
 	responseCounter int
 	robotsChecker   *RobotsChecker
+	faviconChecker  *FaviconChecker
 	prStream        chan *PageReportMessage
 	allowedDomains  map[string]bool
 	httpCrawler     *http_crawler.HttpCrawler
 	qStream         chan string
+
+	externalLinkChecker *ExternalLinkChecker
 }
 
 func NewCrawler(url *url.URL, options *Options) *Crawler {
@@ -80,6 +88,7 @@ func NewCrawler(url *url.URL, options *Options) *Crawler {
 		sitemaps:        sitemaps,
 		robotsChecker:   robotsChecker,
 		robotstxtExists: robotsChecker.Exists(url),
+		faviconChecker:  NewFaviconChecker(),
 		allowedDomains:  map[string]bool{mainDomain: true, "www." + mainDomain: true},
 		prStream:        make(chan *PageReportMessage),
 		qStream:         qStream,
@@ -94,6 +103,10 @@ func NewCrawler(url *url.URL, options *Options) *Crawler {
 		),
 	}
 
+	if options.CheckExternalLinks {
+		c.externalLinkChecker = NewExternalLinkChecker(options.UserAgent)
+	}
+
 	go c.queueStreamer(ctx)
 	go func() {
 		c.crawl(ctx)
@@ -160,7 +173,11 @@ func (c *Crawler) handleResponse(r *http_crawler.ResponseMessage) error {
 		return r.Error
 	}
 
-	pageReport, err := html_parser.NewFromHTTPResponse(r.Response)
+	pageReport, err := html_parser.NewFromHTTPResponse(r.Response, &html_parser.Options{
+		Extractors:  c.options.Extractors,
+		SearchRules: c.options.SearchRules,
+		Bot:         c.options.Bot,
+	})
 	if err != nil {
 		return err
 	}
@@ -173,6 +190,11 @@ func (c *Crawler) handleResponse(r *http_crawler.ResponseMessage) error {
 	pageReport.BlockedByRobotstxt = c.robotsChecker.IsBlocked(parsedURL)
 	pageReport.InSitemap = c.sitemapStorage.Seen(r.URL)
 
+	// Pages without a favicon link use the favicon.ico file in the root, if there's one.
+	if pageReport.MediaType == "text/html" && pageReport.PageSetup.Favicon == "" {
+		pageReport.PageSetup.Favicon = c.faviconChecker.ImplicitFavicon(parsedURL)
+	}
+
 	if pageReport.Nofollow == true && c.options.FollowNofollow == false {
 		return nil
 	}
@@ -217,6 +239,12 @@ func (c *Crawler) handleResponse(r *http_crawler.ResponseMessage) error {
 	}
 
 	if pageReport.Noindex == false || c.options.IncludeNoindex == true {
+		if c.externalLinkChecker != nil {
+			for _, l := range pageReport.ExternalLinks {
+				c.externalLinkChecker.Add(l.ParsedURL)
+			}
+		}
+
 		c.prStream <- &PageReportMessage{
 			PageReport: pageReport,
 			Crawled:    c.responseCounter,
@@ -272,6 +300,17 @@ func (c *Crawler) SitemapExists() bool {
 	return c.sitemapExists
 }
 
+// Returns the status of the external links found during the crawl. It waits until
+// all the external links have been checked. If the CheckExternalLinks option is not
+// set it returns an empty slice.
+func (c *Crawler) ExternalLinkStatuses() []models.ExternalLinkStatus {
+	if c.externalLinkChecker == nil {
+		return []models.ExternalLinkStatus{}
+	}
+
+	return c.externalLinkChecker.Statuses()
+}
+
 // Returns true if the robots.txt file exists
 func (c *Crawler) RobotstxtExists() bool {
 	return c.robotstxtExists
@@ -294,7 +333,7 @@ func (c *Crawler) getCrawlableLinks(p *models.PageReport) []*url.URL {
 	return urls
 }
 
-// Returns a slice containing all the resource URLs from a PageReport.
+// Returns a slice containing all the resource URLs from a PageReport, including the og:image URL.
 // The resource URLs are always considered crawlable.
 func (c *Crawler) getResourceURLs(p *models.PageReport) []*url.URL {
 	var urls []*url.URL
@@ -309,6 +348,10 @@ func (c *Crawler) getResourceURLs(p *models.PageReport) []*url.URL {
 	resources = append(resources, p.Audios...)
 	resources = append(resources, p.Videos...)
 
+	if p.SocialTags.OGImageURL != "" {
+		resources = append(resources, p.SocialTags.OGImageURL)
+	}
+
 	for _, v := range resources {
 		t, err := url.Parse(v)
 		if err != nil {
@@ -320,7 +363,7 @@ func (c *Crawler) getResourceURLs(p *models.PageReport) []*url.URL {
 	return urls
 }
 
-// Returns a slice of crawlable URLs extracted from the Hreflangs, Iframes,
+// Returns a slice of crawlable URLs extracted from the Hreflangs, link relations, Iframes,
 // Redirect URLs and Canonical URLs found in the PageReport.
 // The URLs are considered crawlable only if its domain is allowed by the crawler.
 func (c *Crawler) getCrawlableURLs(p *models.PageReport) []*url.URL {
@@ -331,6 +374,10 @@ func (c *Crawler) getCrawlableURLs(p *models.PageReport) []*url.URL {
 		resources = append(resources, l.URL)
 	}
 
+	for _, l := range p.LinkRelations {
+		resources = append(resources, l.URL)
+	}
+
 	for _, l := range p.Iframes {
 		resources = append(resources, l)
 	}
@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/stjudewashere/seonaut/internal/cache_manager"
+	"github.com/stjudewashere/seonaut/internal/html_parser"
+	"github.com/stjudewashere/seonaut/internal/keywords"
 	"github.com/stjudewashere/seonaut/internal/models"
 	"github.com/stjudewashere/seonaut/internal/pubsub"
 	"github.com/stjudewashere/seonaut/internal/report_manager"
@@ -24,14 +27,19 @@ const (
 // It is loaded from the config package.
 type Config struct {
 	Agent string `mapstructure:"agent"`
+	Bot   string `mapstructure:"bot"`
 }
 
 type Storage interface {
 	SaveCrawl(models.Project) (*models.Crawl, error)
 	SavePageReport(*models.PageReport, int64) (*models.PageReport, error)
 	SaveEndCrawl(*models.Crawl) (*models.Crawl, error)
+	SaveExternalLinkStatuses([]models.ExternalLinkStatus, int64)
+	SaveSiteKeywords(int64, []models.SiteKeyword)
 	GetLastCrawls(models.Project, int) []models.Crawl
 	GetPreviousCrawl(*models.Project) (*models.Crawl, error)
+	FindExtractors(projectId int64) []models.Extractor
+	FindSearchRules(projectId int64) []models.SearchRule
 	DeleteCrawl(c *models.Crawl)
 }
 
@@ -47,6 +55,8 @@ type Service struct {
 	config        *Config
 	cacheManager  *cache_manager.CacheManager
 	reportManager *report_manager.ReportManager
+	locks         map[int64]*sync.Mutex
+	locksMutex    *sync.Mutex
 }
 
 func NewService(s Storage, broker *pubsub.Broker, c *Config, cm *cache_manager.CacheManager, rm *report_manager.ReportManager) *Service {
@@ -56,9 +66,28 @@ func NewService(s Storage, broker *pubsub.Broker, c *Config, cm *cache_manager.C
 		config:        c,
 		cacheManager:  cm,
 		reportManager: rm,
+		locks:         make(map[int64]*sync.Mutex),
+		locksMutex:    &sync.Mutex{},
 	}
 }
 
+// LockProject locks the project until the returned unlock function is called. It is used to
+// serialize the crawls of a project and the updates of its issues, so a crawl never starts
+// while the issues of the previous crawl are being updated and the other way around.
+func (s *Service) LockProject(pid int64) func() {
+	s.locksMutex.Lock()
+	l, ok := s.locks[pid]
+	if !ok {
+		l = &sync.Mutex{}
+		s.locks[pid] = l
+	}
+	s.locksMutex.Unlock()
+
+	l.Lock()
+
+	return l.Unlock
+}
+
 // StartCrawler creates a new crawler and crawls the project's URL
 func (s *Service) StartCrawler(p models.Project) (*models.Crawl, error) {
 	u, err := url.Parse(p.URL)
@@ -76,11 +105,16 @@ func (s *Service) StartCrawler(p models.Project) (*models.Crawl, error) {
 		FollowNofollow:  p.FollowNofollow,
 		IncludeNoindex:  p.IncludeNoindex,
 		UserAgent:       s.config.Agent,
+		Bot:             s.config.Bot,
 		CrawlSitemap:    p.CrawlSitemap,
 		AllowSubdomains: p.AllowSubdomains,
 		BasicAuth:       p.BasicAuth,
 		AuthUser:        p.AuthUser,
 		AuthPass:        p.AuthPass,
+
+		CheckExternalLinks: p.CheckExternalLinks,
+		Extractors:         html_parser.CompileExtractors(s.store.FindExtractors(p.Id)),
+		SearchRules:        html_parser.CompileSearchRules(s.store.FindSearchRules(p.Id)),
 	}
 
 	crawl, err := s.store.SaveCrawl(p)
@@ -89,6 +123,7 @@ func (s *Service) StartCrawler(p models.Project) (*models.Crawl, error) {
 	}
 
 	c := NewCrawler(u, options)
+	siteTerms := keywords.NewSiteTerms()
 
 	for r := range c.Stream() {
 		// URLs are added to the TotalURLs count if they are not blocked
@@ -128,17 +163,30 @@ func (s *Service) StartCrawler(p models.Project) (*models.Crawl, error) {
 			}
 		}
 
+		// Only the terms of the indexable pages are added to the site keywords.
+		// The term counts are not needed once they are added.
+		if isIndexable(r.PageReport) {
+			siteTerms.Add(r.PageReport.Terms, r.PageReport.Title, r.PageReport.H1)
+		}
+		r.PageReport.Terms = nil
+
 		r.PageReport, err = s.store.SavePageReport(r.PageReport, crawl.Id)
 		if err != nil {
 			log.Printf("SavePageReport: %v\n", err)
 			continue
 		}
 
-		s.reportManager.CreatePageIssues(r.PageReport, crawl)
+		s.reportManager.CreatePageIssues(r.PageReport, crawl, &p.Thresholds)
 
 		s.broker.Publish(fmt.Sprintf("crawl-%d", p.Id), &pubsub.Message{Name: "PageReport", Data: r})
 	}
 
+	s.store.SaveSiteKeywords(crawl.Id, siteTerms.Top(keywords.SiteKeywordsLimit))
+
+	if p.CheckExternalLinks {
+		s.store.SaveExternalLinkStatuses(c.ExternalLinkStatuses(), crawl.Id)
+	}
+
 	crawl.RobotstxtExists = c.RobotstxtExists()
 	crawl.SitemapExists = c.SitemapExists()
 
@@ -171,3 +219,13 @@ func (s *Service) GetLastCrawls(p models.Project) []models.Crawl {
 
 	return crawls
 }
+
+// Returns true if the page report is a 200 page that can be indexed by search engines,
+// it is not blocked by the robots.txt, it has no noindex and it is not canonicalized
+// to a different URL.
+func isIndexable(pr *models.PageReport) bool {
+	return pr.StatusCode == 200 &&
+		!pr.BlockedByRobotstxt &&
+		!pr.Noindex &&
+		(pr.Canonical == "" || pr.Canonical == pr.URL)
+}
@@ -0,0 +1,188 @@
+package crawler
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/stjudewashere/seonaut/internal/http_crawler"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+const (
+	// Delay between consecutive requests to the same external host
+	externalHostDelay = 1 * time.Second
+
+	// Max number of workers checking external hosts. Each worker checks one host at a time,
+	// so this limits both the number of goroutines and the number of concurrent requests.
+	externalCheckerWorkers = 4
+
+	// Timeout of each request to an external URL
+	externalRequestTimeout = 5 * time.Second
+
+	// Max time Statuses waits for the queued URLs to be checked once the crawl has ended.
+	// The URLs that have not been checked by then are not reported.
+	externalCheckerDeadline = 2 * time.Minute
+)
+
+type ExternalLinkChecker struct {
+	client   *http_crawler.Client
+	lock     *sync.Mutex
+	wg       *sync.WaitGroup
+	stopped  bool
+	workers  int      // Number of running workers
+	hosts    []string // Hosts with queued URLs waiting for a worker
+	seen     map[string]bool
+	queues   map[string][]string
+	statuses []models.ExternalLinkStatus
+}
+
+func NewExternalLinkChecker(ua string) *ExternalLinkChecker {
+	return &ExternalLinkChecker{
+		client:   http_crawler.NewClient(&http_crawler.ClientOptions{UserAgent: ua, Timeout: externalRequestTimeout}),
+		lock:     &sync.Mutex{},
+		wg:       &sync.WaitGroup{},
+		seen:     make(map[string]bool),
+		queues:   make(map[string][]string),
+		statuses: []models.ExternalLinkStatus{},
+	}
+}
+
+// Adds an external URL to be checked. Each URL is checked only once.
+// URLs are grouped by host so each host has its own queue and its own rate limit.
+// A new worker is started if there are less than externalCheckerWorkers running.
+func (e *ExternalLinkChecker) Add(u *url.URL) {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return
+	}
+
+	s := u.String()
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.seen[s] {
+		return
+	}
+	e.seen[s] = true
+
+	q, queued := e.queues[u.Host]
+	e.queues[u.Host] = append(q, s)
+	if queued {
+		return
+	}
+
+	e.hosts = append(e.hosts, u.Host)
+	if e.workers < externalCheckerWorkers {
+		e.workers++
+		e.wg.Add(1)
+		go e.worker()
+	}
+}
+
+// Returns the status of all the checked external URLs.
+// It blocks until all the queued URLs have been checked or until externalCheckerDeadline
+// is reached, in which case the pending URLs are discarded.
+func (e *ExternalLinkChecker) Statuses() []models.ExternalLinkStatus {
+	return e.statusesWithDeadline(externalCheckerDeadline)
+}
+
+// Waits for the queued URLs to be checked for up to the deadline duration and returns the
+// status of the checked URLs. The host workers are stopped once the deadline is reached.
+func (e *ExternalLinkChecker) statusesWithDeadline(deadline time.Duration) []models.ExternalLinkStatus {
+	done := make(chan struct{})
+	go func() {
+		e.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(deadline):
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.stopped = true
+	statuses := make([]models.ExternalLinkStatus, len(e.statuses))
+	copy(statuses, e.statuses)
+
+	return statuses
+}
+
+// Checks the hosts waiting for a worker one after another. The worker exits
+// once there are no hosts left or the checker has been stopped.
+func (e *ExternalLinkChecker) worker() {
+	defer e.wg.Done()
+
+	for {
+		e.lock.Lock()
+		if len(e.hosts) == 0 || e.stopped {
+			e.workers--
+			e.lock.Unlock()
+			return
+		}
+		host := e.hosts[0]
+		e.hosts = e.hosts[1:]
+		e.lock.Unlock()
+
+		e.checkHost(host)
+	}
+}
+
+// Checks the queued URLs of a host one at a time, waiting externalHostDelay between
+// requests. The host's queue is removed once it is empty.
+func (e *ExternalLinkChecker) checkHost(host string) {
+	for {
+		e.lock.Lock()
+		q := e.queues[host]
+		if len(q) == 0 || e.stopped {
+			delete(e.queues, host)
+			e.lock.Unlock()
+			return
+		}
+		u := q[0]
+		e.queues[host] = q[1:]
+		e.lock.Unlock()
+
+		status := e.status(u)
+
+		e.lock.Lock()
+		if !e.stopped {
+			e.statuses = append(e.statuses, models.ExternalLinkStatus{URL: u, StatusCode: status})
+		}
+
+		// Don't wait after the last URL of the host.
+		if len(e.queues[host]) == 0 || e.stopped {
+			delete(e.queues, host)
+			e.lock.Unlock()
+			return
+		}
+		e.lock.Unlock()
+
+		time.Sleep(externalHostDelay)
+	}
+}
+
+// Returns the status code of an URL using a HEAD request. It falls back to a GET
+// request if the HEAD request fails or the method is not allowed by the server.
+// A status code of 0 is returned if the URL could not be fetched.
+func (e *ExternalLinkChecker) status(u string) int {
+	resp, err := e.client.Head(u)
+	if err == nil {
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusMethodNotAllowed && resp.StatusCode != http.StatusNotImplemented {
+			return resp.StatusCode
+		}
+	}
+
+	resp, err = e.client.Get(u)
+	if err != nil {
+		return 0
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode
+}
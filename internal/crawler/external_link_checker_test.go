@@ -0,0 +1,97 @@
+package crawler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Test the checker returns the status of the checked URLs without waiting
+// for the slow hosts once the deadline is reached.
+func TestExternalLinkCheckerDeadline(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	defer close(release)
+
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer fast.Close()
+
+	e := NewExternalLinkChecker("test")
+	for _, s := range []string{slow.URL + "/a", fast.URL + "/a"} {
+		u, err := url.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		e.Add(u)
+	}
+
+	start := time.Now()
+	statuses := e.statusesWithDeadline(500 * time.Millisecond)
+	if time.Since(start) > 2*time.Second {
+		t.Errorf("TestExternalLinkCheckerDeadline: statuses took %v", time.Since(start))
+	}
+
+	if len(statuses) != 1 {
+		t.Fatalf("TestExternalLinkCheckerDeadline: %d statuses != 1", len(statuses))
+	}
+
+	if statuses[0].StatusCode != http.StatusNotFound {
+		t.Errorf("TestExternalLinkCheckerDeadline: status %d != 404", statuses[0].StatusCode)
+	}
+}
+
+// Test the number of hosts checked concurrently is limited to externalCheckerWorkers
+// and that the checker doesn't wait after the last URL of each host.
+func TestExternalLinkCheckerWorkers(t *testing.T) {
+	var lock sync.Mutex
+	running, max := 0, 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		running++
+		if running > max {
+			max = running
+		}
+		lock.Unlock()
+
+		time.Sleep(50 * time.Millisecond)
+
+		lock.Lock()
+		running--
+		lock.Unlock()
+	})
+
+	e := NewExternalLinkChecker("test")
+	hosts := 3 * externalCheckerWorkers
+	for i := 0; i < hosts; i++ {
+		ts := httptest.NewServer(handler)
+		defer ts.Close()
+
+		u, err := url.Parse(ts.URL + "/a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		e.Add(u)
+	}
+
+	start := time.Now()
+	statuses := e.statusesWithDeadline(10 * time.Second)
+	if time.Since(start) >= externalHostDelay {
+		t.Errorf("TestExternalLinkCheckerWorkers: statuses took %v", time.Since(start))
+	}
+
+	if len(statuses) != hosts {
+		t.Errorf("TestExternalLinkCheckerWorkers: %d statuses != %d", len(statuses), hosts)
+	}
+
+	if max > externalCheckerWorkers {
+		t.Errorf("TestExternalLinkCheckerWorkers: %d concurrent requests > %d", max, externalCheckerWorkers)
+	}
+}
@@ -0,0 +1,46 @@
+package crawler
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// FaviconChecker checks if the hosts have a favicon.ico file in their root. Browsers
+// use it as the favicon of the pages that don't have a favicon link.
+type FaviconChecker struct {
+	lock     *sync.Mutex
+	favicons map[string]string
+}
+
+func NewFaviconChecker() *FaviconChecker {
+	return &FaviconChecker{
+		lock:     &sync.Mutex{},
+		favicons: make(map[string]string),
+	}
+}
+
+// Returns the URL of the favicon.ico file in the root of the URL's host, or an
+// empty string if it doesn't exist. Each host is only checked once.
+func (fc *FaviconChecker) ImplicitFavicon(u *url.URL) string {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+
+	key := u.Scheme + "://" + u.Host
+	if favicon, ok := fc.favicons[key]; ok {
+		return favicon
+	}
+
+	favicon := ""
+	resp, err := http.Head(key + "/favicon.ico")
+	if err == nil {
+		resp.Body.Close()
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			favicon = key + "/favicon.ico"
+		}
+	}
+
+	fc.favicons[key] = favicon
+
+	return favicon
+}
@@ -0,0 +1,42 @@
+package crawler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// Test the checker returns the root favicon.ico URL only if the host serves it,
+// and that each host is requested once.
+func TestFaviconChecker(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/favicon.ico" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	missing := httptest.NewServer(http.NotFoundHandler())
+	defer missing.Close()
+
+	fc := NewFaviconChecker()
+
+	u, _ := url.Parse(server.URL + "/page")
+	for i := 0; i < 2; i++ {
+		if favicon := fc.ImplicitFavicon(u); favicon != server.URL+"/favicon.ico" {
+			t.Errorf("TestFaviconChecker: favicon %s != %s", favicon, server.URL+"/favicon.ico")
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("TestFaviconChecker: requests %d != 1", requests)
+	}
+
+	u, _ = url.Parse(missing.URL + "/page")
+	if favicon := fc.ImplicitFavicon(u); favicon != "" {
+		t.Errorf("TestFaviconChecker: favicon should be empty, got %s", favicon)
+	}
+}
@@ -0,0 +1,54 @@
+package datastore
+
+import (
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/report"
+)
+
+// CountCacheByResourceType returns, for each resource type, the number of successful responses
+// in the crawl and how many of them are cacheable, cacheable for at least a week and have an
+// ETag or Last-Modified validator.
+func (ds *Datastore) CountCacheByResourceType(cid int64) *report.CacheCount {
+	query := `
+		SELECT
+			CASE
+				WHEN pagereports.media_type = "text/html" THEN "HTML"
+				WHEN pagereports.media_type LIKE "image/%" THEN "Images"
+				WHEN pagereports.media_type IN ("text/javascript", "application/javascript", "application/x-javascript") THEN "Scripts"
+				WHEN pagereports.media_type = "text/css" THEN "Styles"
+				ELSE "Other"
+			END AS resource_type,
+			COUNT(*),
+			COUNT(IF(cache_headers.max_age > 0, 1, NULL)),
+			COUNT(IF(cache_headers.max_age >= 604800, 1, NULL)),
+			COUNT(IF(cache_headers.etag != "" OR cache_headers.last_modified != "", 1, NULL))
+		FROM pagereports
+		LEFT JOIN cache_headers ON cache_headers.pagereport_id = pagereports.id
+		WHERE pagereports.crawl_id = ? AND pagereports.crawled = 1
+			AND pagereports.status_code >= 200 AND pagereports.status_code < 300
+		GROUP BY resource_type
+		ORDER BY COUNT(*) DESC`
+
+	c := report.CacheCount{}
+
+	rows, err := ds.db.Query(query, cid)
+	if err != nil {
+		log.Println(err)
+		return &c
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		r := report.ResourceCache{}
+		err := rows.Scan(&r.Type, &r.URLs, &r.Cacheable, &r.LongCache, &r.Validators)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		c = append(c, r)
+	}
+
+	return &c
+}
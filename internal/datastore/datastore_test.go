@@ -24,3 +24,18 @@ func TestHash(t *testing.T) {
 		t.Error("Error hashing url2")
 	}
 }
+
+func TestLikePattern(t *testing.T) {
+	tests := map[string]string{
+		"":                "%%",
+		"/filter/":        "%/filter/%",
+		"/shop/*/color_1": `%/shop/%/color\_1%`,
+		"100%":            `%100\%%`,
+	}
+
+	for pattern, want := range tests {
+		if got := datastore.LikePattern(pattern); got != want {
+			t.Errorf("LikePattern(%q): %s != %s", pattern, got, want)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package datastore
+
+import (
+	"database/sql"
+	"log"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// FindDuplicatePages returns the canonical HTML pages of a crawl that have a content fingerprint.
+// Those are the pages considered in the duplicate content clusters.
+func (ds *Datastore) FindDuplicatePages(cid int64) []models.DuplicatePage {
+	return ds.findDuplicatePages(cid, false)
+}
+
+// FindDuplicateClusterPages returns the pages of a crawl that are part of a duplicate content
+// cluster, sorted by cluster.
+func (ds *Datastore) FindDuplicateClusterPages(cid int64) []models.DuplicatePage {
+	return ds.findDuplicatePages(cid, true)
+}
+
+// Returns the duplicate content candidate pages of a crawl. If clustered is true only the
+// pages that are part of a duplicate content cluster are returned.
+func (ds *Datastore) findDuplicatePages(cid int64, clustered bool) []models.DuplicatePage {
+	pages := []models.DuplicatePage{}
+	query := `
+		SELECT
+			pagereports.id,
+			pagereports.url,
+			pagereports.title,
+			pagereports.content_hash,
+			pagereports.simhash,
+			pagereports.duplicate_cluster,
+			duplicate_canonicals.id IS NOT NULL
+		FROM pagereports
+		LEFT JOIN duplicate_canonicals ON duplicate_canonicals.pagereport_id = pagereports.id
+		WHERE pagereports.crawl_id = ? AND media_type = "text/html" AND status_code >= 200
+		AND status_code < 300 AND (canonical = "" OR canonical = url) AND crawled = 1
+		AND content_hash != ""`
+
+	if clustered {
+		query += " AND pagereports.duplicate_cluster > 0 ORDER BY pagereports.duplicate_cluster, pagereports.id"
+	} else {
+		query += " ORDER BY pagereports.id"
+	}
+
+	rows, err := ds.db.Query(query, cid)
+	if err != nil {
+		log.Println(err)
+		return pages
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		p := models.DuplicatePage{}
+		err := rows.Scan(
+			&p.PageReport.Id,
+			&p.PageReport.URL,
+			&p.PageReport.Title,
+			&p.PageReport.ContentHash,
+			&p.PageReport.SimHash,
+			&p.Cluster,
+			&p.Canonical,
+		)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		pages = append(pages, p)
+	}
+
+	return pages
+}
+
+// SaveDuplicateClusters stores the duplicate content clusters of a crawl. Each cluster is a slice
+// of PageReport ids, and the clusters are numbered starting from 1. Any previous clusters of the
+// crawl are removed, as well as the selected canonical pages that are not in canonicals.
+func (ds *Datastore) SaveDuplicateClusters(cid int64, clusters [][]int64, canonicals []int64) error {
+	tx, err := ds.db.Begin()
+	if err != nil {
+		log.Printf("SaveDuplicateClusters: %v\n", err)
+		return err
+	}
+
+	_, err = tx.Exec("UPDATE pagereports SET duplicate_cluster = 0 WHERE crawl_id = ? AND duplicate_cluster > 0", cid)
+	if err != nil {
+		return rollback(tx, "SaveDuplicateClusters", err)
+	}
+
+	for i, cluster := range clusters {
+		if len(cluster) == 0 {
+			continue
+		}
+
+		v := []interface{}{i + 1, cid}
+		for _, id := range cluster {
+			v = append(v, id)
+		}
+
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(cluster)), ",")
+		query := "UPDATE pagereports SET duplicate_cluster = ? WHERE crawl_id = ? AND id IN (" + placeholders + ")"
+		_, err = tx.Exec(query, v...)
+		if err != nil {
+			return rollback(tx, "SaveDuplicateClusters", err)
+		}
+	}
+
+	query := "DELETE FROM duplicate_canonicals WHERE crawl_id = ?"
+	v := []interface{}{cid}
+	if len(canonicals) > 0 {
+		for _, id := range canonicals {
+			v = append(v, id)
+		}
+
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(canonicals)), ",")
+		query += " AND pagereport_id NOT IN (" + placeholders + ")"
+	}
+
+	_, err = tx.Exec(query, v...)
+	if err != nil {
+		return rollback(tx, "SaveDuplicateClusters", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("SaveDuplicateClusters: %v\n", err)
+	}
+
+	return err
+}
+
+// SaveDuplicateCanonical selects the PageReport with the pageReportId as the canonical page of
+// the duplicate content cluster. Any previous selection in the cluster is removed.
+func (ds *Datastore) SaveDuplicateCanonical(cid int64, cluster []int64, pageReportId int64) error {
+	if len(cluster) == 0 {
+		return nil
+	}
+
+	v := []interface{}{cid}
+	for _, id := range cluster {
+		v = append(v, id)
+	}
+
+	tx, err := ds.db.Begin()
+	if err != nil {
+		log.Printf("SaveDuplicateCanonical: %v\n", err)
+		return err
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(cluster)), ",")
+	query := "DELETE FROM duplicate_canonicals WHERE crawl_id = ? AND pagereport_id IN (" + placeholders + ")"
+	_, err = tx.Exec(query, v...)
+	if err != nil {
+		return rollback(tx, "SaveDuplicateCanonical", err)
+	}
+
+	query = "INSERT INTO duplicate_canonicals (crawl_id, pagereport_id) VALUES (?, ?)"
+	_, err = tx.Exec(query, cid, pageReportId)
+	if err != nil {
+		return rollback(tx, "SaveDuplicateCanonical", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Printf("SaveDuplicateCanonical: %v\n", err)
+	}
+
+	return err
+}
+
+// Rolls back the transaction after an error, logging the error with the name
+// of the function that failed. The original error is returned.
+func rollback(tx *sql.Tx, name string, err error) error {
+	log.Printf("%s: %v\n", name, err)
+	if rerr := tx.Rollback(); rerr != nil {
+		log.Printf("%s rollback: %v\n", name, rerr)
+	}
+
+	return err
+}
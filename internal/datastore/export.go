@@ -326,3 +326,127 @@ func (ds *Datastore) ExportHreflangs(crawl *models.Crawl) <-chan *export.Hreflan
 
 	return vStream
 }
+
+// Send all the images, scripts and styles returning redirects or errors through a read-only channel
+func (ds *Datastore) ExportBrokenResources(crawl *models.Crawl) <-chan *export.BrokenResource {
+	vStream := make(chan *export.BrokenResource)
+
+	go func() {
+		defer close(vStream)
+
+		query := `
+			SELECT
+				pagereports.url,
+				r.url,
+				r.status_code
+			FROM (
+				SELECT pagereport_id, url_hash FROM images WHERE crawl_id = ?
+				UNION ALL
+				SELECT pagereport_id, url_hash FROM scripts WHERE crawl_id = ?
+				UNION ALL
+				SELECT pagereport_id, url_hash FROM styles WHERE crawl_id = ?
+			) AS resources
+			INNER JOIN pagereports ON pagereports.id = resources.pagereport_id
+			INNER JOIN pagereports AS r ON r.url_hash = resources.url_hash AND r.crawl_id = ?
+			WHERE r.status_code >= 300`
+
+		rows, err := ds.db.Query(query, crawl.Id, crawl.Id, crawl.Id, crawl.Id)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		for rows.Next() {
+			v := &export.BrokenResource{}
+			err := rows.Scan(&v.Origin, &v.Resource, &v.StatusCode)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+
+			vStream <- v
+		}
+	}()
+
+	return vStream
+}
+
+// Send the structured data items through a read-only channel
+func (ds *Datastore) ExportStructuredData(crawl *models.Crawl) <-chan *export.StructuredData {
+	vStream := make(chan *export.StructuredData)
+
+	go func() {
+		defer close(vStream)
+
+		query := `
+			SELECT
+				pagereports.url,
+				structured_data.format,
+				structured_data.type,
+				IFNULL(structured_data.properties, ""),
+				structured_data.valid
+			FROM structured_data
+			INNER JOIN pagereports ON pagereports.id = structured_data.pagereport_id
+			WHERE structured_data.crawl_id = ?`
+
+		rows, err := ds.db.Query(query, crawl.Id)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		for rows.Next() {
+			v := &export.StructuredData{}
+			err := rows.Scan(&v.Origin, &v.Format, &v.Type, &v.Properties, &v.Valid)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+
+			vStream <- v
+		}
+	}()
+
+	return vStream
+}
+
+func (ds *Datastore) ExportKeywords(crawl *models.Crawl) <-chan *export.Keyword {
+	vStream := make(chan *export.Keyword)
+
+	go func() {
+		defer close(vStream)
+
+		query := `
+			SELECT
+				pagereports.url,
+				keywords.term,
+				keywords.words,
+				keywords.count,
+				keywords.in_title,
+				keywords.in_h1,
+				keywords.is_primary
+			FROM keywords
+			INNER JOIN pagereports ON pagereports.id = keywords.pagereport_id
+			WHERE keywords.crawl_id = ?
+			ORDER BY keywords.pagereport_id, keywords.words, keywords.count DESC`
+
+		rows, err := ds.db.Query(query, crawl.Id)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		for rows.Next() {
+			v := &export.Keyword{}
+			err := rows.Scan(&v.Origin, &v.Term, &v.Words, &v.Count, &v.InTitle, &v.InH1, &v.Primary)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+
+			vStream <- v
+		}
+	}()
+
+	return vStream
+}
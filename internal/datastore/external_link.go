@@ -0,0 +1,45 @@
+package datastore
+
+import (
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// SaveExternalLinkStatuses stores the status code of each of the unique external URLs
+// checked during the crawl.
+func (ds *Datastore) SaveExternalLinkStatuses(statuses []models.ExternalLinkStatus, cid int64) {
+	query := "INSERT INTO external_link_status (crawl_id, url, url_hash, status_code) VALUES "
+	sqlString := ""
+	v := []interface{}{}
+
+	fn := func() {
+		sqlString = sqlString[0 : len(sqlString)-1]
+		stmt, err := ds.db.Prepare(query + sqlString)
+		if err != nil {
+			log.Printf("SaveExternalLinkStatuses: %v\n", err)
+			return
+		}
+		defer stmt.Close()
+
+		_, err = stmt.Exec(v...)
+		if err != nil {
+			log.Printf("SaveExternalLinkStatuses: %v\n", err)
+		}
+	}
+
+	for _, s := range statuses {
+		sqlString += "(?, ?, ?, ?),"
+		v = append(v, cid, s.URL, Hash(s.URL), s.StatusCode)
+
+		if len(v) >= 400 {
+			fn()
+			v = []interface{}{}
+			sqlString = ""
+		}
+	}
+
+	if len(v) > 0 {
+		fn()
+	}
+}
@@ -0,0 +1,129 @@
+package datastore
+
+import (
+	"log"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// FindExtractors returns the custom extractors of a project.
+func (ds *Datastore) FindExtractors(pid int64) []models.Extractor {
+	extractors := []models.Extractor{}
+	query := `
+		SELECT
+			id,
+			project_id,
+			name,
+			type,
+			expression
+		FROM extractors
+		WHERE project_id = ?
+		ORDER BY id`
+
+	rows, err := ds.db.Query(query, pid)
+	if err != nil {
+		log.Println(err)
+		return extractors
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		e := models.Extractor{}
+		err := rows.Scan(&e.Id, &e.ProjectId, &e.Name, &e.Type, &e.Expression)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		extractors = append(extractors, e)
+	}
+
+	return extractors
+}
+
+// SaveExtractor stores a new custom extractor.
+func (ds *Datastore) SaveExtractor(e *models.Extractor) error {
+	query := "INSERT INTO extractors (project_id, name, type, expression) VALUES (?, ?, ?, ?)"
+	_, err := ds.db.Exec(query, e.ProjectId, e.Name, e.Type, e.Expression)
+	if err != nil {
+		log.Printf("SaveExtractor: %v\n", err)
+	}
+
+	return err
+}
+
+// DeleteExtractor deletes a project's custom extractor.
+func (ds *Datastore) DeleteExtractor(id, pid int64) {
+	_, err := ds.db.Exec("DELETE FROM extractors WHERE id = ? AND project_id = ?", id, pid)
+	if err != nil {
+		log.Printf("DeleteExtractor: %v\n", err)
+	}
+}
+
+// FindExtractionNames returns the names of the extractors with values in a crawl,
+// in the order they were first extracted.
+func (ds *Datastore) FindExtractionNames(cid int64) []string {
+	names := []string{}
+	query := `
+		SELECT
+			name
+		FROM extractions
+		WHERE crawl_id = ?
+		GROUP BY name
+		ORDER BY MIN(id)`
+
+	rows, err := ds.db.Query(query, cid)
+	if err != nil {
+		log.Println(err)
+		return names
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			log.Println(err)
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// FindExtractions returns the extracted values of a crawl by PageReport id.
+// If the ids slice is not empty, only the extractions of those PageReports are returned.
+func (ds *Datastore) FindExtractions(cid int64, ids []int64) map[int64][]models.Extraction {
+	extractions := make(map[int64][]models.Extraction)
+	query := "SELECT pagereport_id, name, value FROM extractions WHERE crawl_id = ?"
+	args := []interface{}{cid}
+
+	if len(ids) > 0 {
+		query += " AND pagereport_id IN (" + strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",") + ")"
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+
+	rows, err := ds.db.Query(query+" ORDER BY id", args...)
+	if err != nil {
+		log.Println(err)
+		return extractions
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		e := models.Extraction{}
+		if err := rows.Scan(&id, &e.Name, &e.Value); err != nil {
+			log.Println(err)
+			continue
+		}
+
+		extractions[id] = append(extractions[id], e)
+	}
+
+	return extractions
+}
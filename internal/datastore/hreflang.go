@@ -0,0 +1,44 @@
+package datastore
+
+import (
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// FindHreflangAnnotations returns the hreflang links of the crawled pages along with the URL,
+// language and id of the page declaring them, sorted by page id.
+func (ds *Datastore) FindHreflangAnnotations(cid int64) []models.HreflangAnnotation {
+	annotations := []models.HreflangAnnotation{}
+	query := `
+		SELECT
+			pagereports.id,
+			pagereports.url,
+			pagereports.lang,
+			hreflangs.to_url,
+			hreflangs.to_lang
+		FROM hreflangs
+		INNER JOIN pagereports ON pagereports.id = hreflangs.pagereport_id
+		WHERE hreflangs.crawl_id = ?
+		ORDER BY pagereports.id, hreflangs.id`
+
+	rows, err := ds.db.Query(query, cid)
+	if err != nil {
+		log.Println(err)
+		return annotations
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		a := models.HreflangAnnotation{}
+		err := rows.Scan(&a.PageReportId, &a.URL, &a.Lang, &a.ToURL, &a.ToLang)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		annotations = append(annotations, a)
+	}
+
+	return annotations
+}
@@ -3,6 +3,7 @@ package datastore
 import (
 	"log"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/stjudewashere/seonaut/internal/issue"
@@ -51,6 +52,47 @@ func (ds *Datastore) SaveIssues(iStream <-chan *models.Issue) {
 	}
 }
 
+// DeleteIssuesByErrorType deletes the crawl's issues of any of the specified error types.
+func (ds *Datastore) DeleteIssuesByErrorType(cid int64, errorTypes []int) {
+	if len(errorTypes) == 0 {
+		return
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(errorTypes)), ",")
+	query := "DELETE FROM issues WHERE crawl_id = ? AND issue_type_id IN (" + placeholders + ")"
+	v := []interface{}{cid}
+	for _, e := range errorTypes {
+		v = append(v, e)
+	}
+
+	_, err := ds.db.Exec(query, v...)
+	if err != nil {
+		log.Printf("DeleteIssuesByErrorType: cid %d %v\n", cid, err)
+	}
+}
+
+// SyncIssueTypes inserts the issue types into the issue_types table,
+// updating the code, priority and category of the existing ones.
+func (ds *Datastore) SyncIssueTypes(issueTypes []models.IssueType) error {
+	if len(issueTypes) == 0 {
+		return nil
+	}
+
+	query := "INSERT INTO issue_types (id, type, priority, category) VALUES "
+	v := []interface{}{}
+	for _, t := range issueTypes {
+		query += "(?, ?, ?, ?),"
+		v = append(v, t.Id, t.Code, t.Priority, t.Category)
+	}
+
+	query = strings.TrimSuffix(query, ",")
+	query += " ON DUPLICATE KEY UPDATE type = VALUES(type), priority = VALUES(priority), category = VALUES(category)"
+
+	_, err := ds.db.Exec(query, v...)
+
+	return err
+}
+
 func (ds *Datastore) FindIssuesByPriority(cid int64, p int) []issue.IssueGroup {
 	issues := []issue.IssueGroup{}
 	query := `
@@ -60,7 +102,7 @@ func (ds *Datastore) FindIssuesByPriority(cid int64, p int) []issue.IssueGroup {
 			count(DISTINCT issues.pagereport_id) AS c
 		FROM issues
 		INNER JOIN  issue_types ON issue_types.id = issues.issue_type_id
-		WHERE crawl_id = ? AND issue_types.priority = ? GROUP BY issue_type_id
+		WHERE crawl_id = ? AND issue_types.priority = ? AND issues.suppressed = 0 GROUP BY issue_type_id
 		ORDER BY c DESC`
 
 	rows, err := ds.db.Query(query, cid, p)
@@ -90,7 +132,7 @@ func (ds *Datastore) FindErrorTypesByPage(pid int, cid int64) []string {
 			issue_types.type
 		FROM issues
 		INNER JOIN issue_types ON issue_types.id = issues.issue_type_id
-		WHERE pagereport_id = ? and crawl_id = ?
+		WHERE pagereport_id = ? and crawl_id = ? AND issues.suppressed = 0
 		GROUP BY issue_type_id`
 
 	rows, err := ds.db.Query(query, pid, cid)
@@ -112,14 +154,14 @@ func (ds *Datastore) FindErrorTypesByPage(pid int, cid int64) []string {
 	return et
 }
 
-func (ds *Datastore) GetNumberOfPagesForIssues(cid int64, errorType string) int {
+func (ds *Datastore) GetNumberOfPagesForIssues(cid int64, errorType string, suppressed bool) int {
 	query := `
 		SELECT count(DISTINCT pagereport_id)
 		FROM issues
 		INNER JOIN issue_types ON issue_types.id = issues.issue_type_id
-		WHERE issue_types.type = ? AND crawl_id  = ?`
+		WHERE issue_types.type = ? AND crawl_id  = ? AND issues.suppressed = ?`
 
-	row := ds.db.QueryRow(query, errorType, cid)
+	row := ds.db.QueryRow(query, errorType, cid, suppressed)
 	var c int
 	if err := row.Scan(&c); err != nil {
 		log.Printf("GetNumberOfPagesForIssues: %v\n", err)
@@ -0,0 +1,154 @@
+package datastore
+
+import (
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// FindPageReportKeywords returns the top terms of a PageReport sorted by the number of words
+// and the number of times they appear in the page.
+func (ds *Datastore) FindPageReportKeywords(rid int) []models.Keyword {
+	keywords := []models.Keyword{}
+	query := `
+		SELECT
+			term,
+			words,
+			count,
+			in_title,
+			in_h1,
+			is_primary
+		FROM keywords
+		WHERE pagereport_id = ?
+		ORDER BY words, count DESC, term`
+
+	rows, err := ds.db.Query(query, rid)
+	if err != nil {
+		log.Println(err)
+		return keywords
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		k := models.Keyword{}
+		err := rows.Scan(&k.Term, &k.Words, &k.Count, &k.InTitle, &k.InH1, &k.Primary)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		keywords = append(keywords, k)
+	}
+
+	return keywords
+}
+
+// SaveSiteKeywords saves the site keywords aggregated during the crawl.
+func (ds *Datastore) SaveSiteKeywords(cid int64, keywords []models.SiteKeyword) {
+	if len(keywords) == 0 {
+		return
+	}
+
+	sqlString := "INSERT INTO site_keywords (crawl_id, term, words, count, pages, titles, h1s) values "
+	v := []interface{}{}
+	for _, k := range keywords {
+		sqlString += "(?, ?, ?, ?, ?, ?, ?),"
+		v = append(v, cid, k.Term, k.Words, k.Count, k.Pages, k.Titles, k.H1s)
+	}
+	sqlString = sqlString[0 : len(sqlString)-1]
+
+	_, err := ds.db.Exec(sqlString, v...)
+	if err != nil {
+		log.Printf("SaveSiteKeywords\nCID: %v\nError: %+v\n", cid, err)
+	}
+}
+
+// FindSiteKeywords returns the terms with the given number of words that are in more
+// pages of the crawl, up to the limit.
+func (ds *Datastore) FindSiteKeywords(cid int64, words int, limit int) []models.SiteKeyword {
+	keywords := []models.SiteKeyword{}
+	query := `
+		SELECT
+			term,
+			words,
+			count,
+			pages,
+			titles,
+			h1s
+		FROM site_keywords
+		WHERE crawl_id = ? AND words = ?
+		ORDER BY pages DESC, count DESC, term
+		LIMIT ?`
+
+	rows, err := ds.db.Query(query, cid, words, limit)
+	if err != nil {
+		log.Println(err)
+		return keywords
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		k := models.SiteKeyword{}
+		err := rows.Scan(&k.Term, &k.Words, &k.Count, &k.Pages, &k.Titles, &k.H1s)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		keywords = append(keywords, k)
+	}
+
+	return keywords
+}
+
+// FindPrimaryKeywordPages returns the indexable pages of a crawl whose primary term is
+// also the primary term of other indexable pages, sorted by term. Indexable pages have
+// a 200 status code, no noindex directive and no canonical to a different URL.
+func (ds *Datastore) FindPrimaryKeywordPages(cid int64) []models.KeywordPage {
+	pages := []models.KeywordPage{}
+	query := `
+		SELECT
+			keywords.term,
+			pagereports.id,
+			pagereports.url,
+			pagereports.title,
+			pagereports.h1
+		FROM keywords
+		INNER JOIN pagereports ON pagereports.id = keywords.pagereport_id
+		WHERE keywords.crawl_id = ? AND keywords.is_primary = 1
+		AND pagereports.status_code = 200 AND pagereports.noindex = 0 AND pagereports.crawled = 1
+		AND (pagereports.canonical = "" OR pagereports.canonical = pagereports.url)
+		AND keywords.term IN (
+			SELECT k.term FROM (
+				SELECT keywords.term
+				FROM keywords
+				INNER JOIN pagereports ON pagereports.id = keywords.pagereport_id
+				WHERE keywords.crawl_id = ? AND keywords.is_primary = 1
+				AND pagereports.status_code = 200 AND pagereports.noindex = 0 AND pagereports.crawled = 1
+				AND (pagereports.canonical = "" OR pagereports.canonical = pagereports.url)
+				GROUP BY keywords.term
+				HAVING COUNT(*) > 1
+			) AS k
+		)
+		ORDER BY keywords.term, pagereports.id`
+
+	rows, err := ds.db.Query(query, cid, cid)
+	if err != nil {
+		log.Println(err)
+		return pages
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		p := models.KeywordPage{}
+		err := rows.Scan(&p.Term, &p.PageReport.Id, &p.PageReport.URL, &p.PageReport.Title, &p.PageReport.H1)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		pages = append(pages, p)
+	}
+
+	return pages
+}
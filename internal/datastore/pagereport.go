@@ -1,9 +1,11 @@
 package datastore
 
 import (
+	"database/sql"
 	"log"
 	"math"
 	"sort"
+	"strings"
 
 	"github.com/stjudewashere/seonaut/internal/models"
 	"github.com/stjudewashere/seonaut/internal/report"
@@ -16,6 +18,11 @@ func (ds *Datastore) SavePageReport(r *models.PageReport, cid int64) (*models.Pa
 		redirectHash = Hash(r.RedirectURL)
 	}
 
+	var canonicalHash string
+	if r.Canonical != "" {
+		canonicalHash = Hash(r.Canonical)
+	}
+
 	query := `
 		INSERT INTO pagereports (
 			crawl_id,
@@ -34,6 +41,7 @@ func (ds *Datastore) SavePageReport(r *models.PageReport, cid int64) (*models.Pa
 			robots,
 			noindex,
 			canonical,
+			canonical_hash,
 			h1,
 			h2,
 			words,
@@ -42,9 +50,17 @@ func (ds *Datastore) SavePageReport(r *models.PageReport, cid int64) (*models.Pa
 			robotstxt_blocked,
 			crawled,
 			in_sitemap,
-			valid_lang
+			valid_lang,
+			content_hash,
+			simhash,
+			text_ratio,
+			sentences,
+			avg_sentence_length,
+			readability,
+			valid_readability,
+			robots_conflict
 		)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	stmt, err := ds.db.Prepare(query)
 	if err != nil {
@@ -69,6 +85,7 @@ func (ds *Datastore) SavePageReport(r *models.PageReport, cid int64) (*models.Pa
 		r.Robots,
 		r.Noindex,
 		r.Canonical,
+		canonicalHash,
 		r.H1,
 		r.H2,
 		r.Words,
@@ -78,6 +95,14 @@ func (ds *Datastore) SavePageReport(r *models.PageReport, cid int64) (*models.Pa
 		r.Crawled,
 		r.InSitemap,
 		r.ValidLang,
+		r.ContentHash,
+		r.SimHash,
+		r.TextRatio,
+		r.Sentences,
+		r.AvgSentenceLength,
+		r.Readability,
+		r.ValidReadability,
+		r.RobotsConflict,
 	)
 	if err != nil {
 		return r, err
@@ -89,12 +114,12 @@ func (ds *Datastore) SavePageReport(r *models.PageReport, cid int64) (*models.Pa
 	}
 
 	if len(r.Links) > 0 {
-		sqlString := "INSERT INTO links (pagereport_id, crawl_id, url, scheme, rel, nofollow, text, url_hash) values "
+		sqlString := "INSERT INTO links (pagereport_id, crawl_id, url, scheme, rel, nofollow, text, url_hash, position, title, blank_noopener, image) values "
 		v := []interface{}{}
 		for _, l := range r.Links {
 			hash := Hash(l.URL)
-			sqlString += "(?, ?, ?, ?, ?, ?, ?, ?),"
-			v = append(v, lid, cid, l.URL, l.ParsedURL.Scheme, l.Rel, l.NoFollow, l.Text, hash)
+			sqlString += "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?),"
+			v = append(v, lid, cid, l.URL, l.ParsedURL.Scheme, l.Rel, l.NoFollow, l.Text, hash, l.Position, l.Title, l.BlankNoOpener, l.Image)
 		}
 		sqlString = sqlString[0 : len(sqlString)-1]
 		stmt, err := ds.db.Prepare(sqlString)
@@ -110,11 +135,11 @@ func (ds *Datastore) SavePageReport(r *models.PageReport, cid int64) (*models.Pa
 	}
 
 	if len(r.ExternalLinks) > 0 {
-		sqlString := "INSERT INTO external_links (pagereport_id, crawl_id, url, rel, nofollow, text, sponsored, ugc) values "
+		sqlString := "INSERT INTO external_links (pagereport_id, crawl_id, url, rel, nofollow, text, sponsored, ugc, url_hash, position, title, blank_noopener, image) values "
 		v := []interface{}{}
 		for _, l := range r.ExternalLinks {
-			sqlString += "(?, ?, ?, ?, ?, ?, ?, ?),"
-			v = append(v, lid, cid, l.URL, l.Rel, l.NoFollow, l.Text, l.Sponsored, l.UGC)
+			sqlString += "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?),"
+			v = append(v, lid, cid, l.URL, l.Rel, l.NoFollow, l.Text, l.Sponsored, l.UGC, Hash(l.URL), l.Position, l.Title, l.BlankNoOpener, l.Image)
 		}
 		sqlString = sqlString[0 : len(sqlString)-1]
 		stmt, err := ds.db.Prepare(sqlString)
@@ -146,12 +171,80 @@ func (ds *Datastore) SavePageReport(r *models.PageReport, cid int64) (*models.Pa
 		}
 	}
 
+	if len(r.RobotsDirectives) > 0 {
+		sqlString := "INSERT INTO robots_directives (pagereport_id, crawl_id, source, bot, name, value) values "
+		v := []interface{}{}
+		for _, d := range r.RobotsDirectives {
+			sqlString += "(?, ?, ?, ?, ?, ?),"
+			v = append(v, lid, cid, d.Source, d.Bot, d.Name, d.Value)
+		}
+		sqlString = sqlString[0 : len(sqlString)-1]
+		stmt, _ := ds.db.Prepare(sqlString)
+		defer stmt.Close()
+
+		_, err := stmt.Exec(v...)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n RobotsDirectives: %+v\nError: %+v\n", cid, v, err)
+		}
+	}
+
+	if len(r.Canonicals) > 0 {
+		sqlString := "INSERT INTO canonicals (pagereport_id, crawl_id, url, source) values "
+		v := []interface{}{}
+		for _, c := range r.Canonicals {
+			sqlString += "(?, ?, ?, ?),"
+			v = append(v, lid, cid, c.URL, c.Source)
+		}
+		sqlString = sqlString[0 : len(sqlString)-1]
+		stmt, _ := ds.db.Prepare(sqlString)
+		defer stmt.Close()
+
+		_, err := stmt.Exec(v...)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n Canonicals: %+v\nError: %+v\n", cid, v, err)
+		}
+	}
+
+	if len(r.LinkRelations) > 0 {
+		sqlString := "INSERT INTO link_relations (pagereport_id, crawl_id, rel, url, media, from_hash, url_hash) values "
+		v := []interface{}{}
+		for _, l := range r.LinkRelations {
+			sqlString += "(?, ?, ?, ?, ?, ?, ?),"
+			v = append(v, lid, cid, l.Rel, l.URL, l.Media, Hash(r.URL), Hash(l.URL))
+		}
+		sqlString = sqlString[0 : len(sqlString)-1]
+		stmt, _ := ds.db.Prepare(sqlString)
+		defer stmt.Close()
+
+		_, err := stmt.Exec(v...)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n LinkRelations: %+v\nError: %+v\n", cid, v, err)
+		}
+	}
+
+	if len(r.Keywords) > 0 {
+		sqlString := "INSERT INTO keywords (pagereport_id, crawl_id, term, words, count, in_title, in_h1, is_primary) values "
+		v := []interface{}{}
+		for _, k := range r.Keywords {
+			sqlString += "(?, ?, ?, ?, ?, ?, ?, ?),"
+			v = append(v, lid, cid, k.Term, k.Words, k.Count, k.InTitle, k.InH1, k.Primary)
+		}
+		sqlString = sqlString[0 : len(sqlString)-1]
+		stmt, _ := ds.db.Prepare(sqlString)
+		defer stmt.Close()
+
+		_, err := stmt.Exec(v...)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n Keywords: %+v\nError: %+v\n", cid, v, err)
+		}
+	}
+
 	if len(r.Images) > 0 {
-		sqlString := "INSERT INTO images (pagereport_id, url, alt, crawl_id) values "
+		sqlString := "INSERT INTO images (pagereport_id, url, alt, crawl_id, url_hash, width, height, srcset) values "
 		v := []interface{}{}
 		for _, i := range r.Images {
-			sqlString += "(?, ?, ?, ?),"
-			v = append(v, lid, i.URL, i.Alt, cid)
+			sqlString += "(?, ?, ?, ?, ?, ?, ?, ?),"
+			v = append(v, lid, i.URL, i.Alt, cid, Hash(i.URL), i.Width, i.Height, i.SrcSet)
 		}
 		sqlString = sqlString[0 : len(sqlString)-1]
 		stmt, _ = ds.db.Prepare(sqlString)
@@ -218,11 +311,11 @@ func (ds *Datastore) SavePageReport(r *models.PageReport, cid int64) (*models.Pa
 	}
 
 	if len(r.Scripts) > 0 {
-		sqlString := "INSERT INTO scripts (pagereport_id, url, crawl_id) values "
+		sqlString := "INSERT INTO scripts (pagereport_id, url, crawl_id, url_hash) values "
 		v := []interface{}{}
 		for _, s := range r.Scripts {
-			sqlString += "(?, ?, ?),"
-			v = append(v, lid, s, cid)
+			sqlString += "(?, ?, ?, ?),"
+			v = append(v, lid, s, cid, Hash(s))
 		}
 		sqlString = sqlString[0 : len(sqlString)-1]
 		stmt, _ := ds.db.Prepare(sqlString)
@@ -235,12 +328,12 @@ func (ds *Datastore) SavePageReport(r *models.PageReport, cid int64) (*models.Pa
 	}
 
 	if len(r.Styles) > 0 {
-		sqlString := "INSERT INTO styles (pagereport_id, url, crawl_id) values "
+		sqlString := "INSERT INTO styles (pagereport_id, url, crawl_id, url_hash) values "
 		v := []interface{}{}
 
 		for _, s := range r.Styles {
-			sqlString += "(?, ?, ?),"
-			v = append(v, lid, s, cid)
+			sqlString += "(?, ?, ?, ?),"
+			v = append(v, lid, s, cid, Hash(s))
 
 		}
 		sqlString = sqlString[0 : len(sqlString)-1]
@@ -253,6 +346,289 @@ func (ds *Datastore) SavePageReport(r *models.PageReport, cid int64) (*models.Pa
 		}
 	}
 
+	if len(r.StructuredData) > 0 {
+		sqlString := "INSERT INTO structured_data (pagereport_id, crawl_id, format, type, properties, valid) values "
+		v := []interface{}{}
+		for _, s := range r.StructuredData {
+			sqlString += "(?, ?, ?, ?, ?, ?),"
+			v = append(v, lid, cid, s.Format, s.Type, strings.Join(s.Properties, ","), s.Valid)
+		}
+		sqlString = sqlString[0 : len(sqlString)-1]
+		stmt, _ := ds.db.Prepare(sqlString)
+		defer stmt.Close()
+
+		_, err := stmt.Exec(v...)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n StructuredData: %+v\nError: %+v\n", cid, v, err)
+		}
+	}
+
+	if len(r.Headings) > 0 {
+		sqlString := "INSERT INTO headings (pagereport_id, crawl_id, level, text, skips_level) values "
+		v := []interface{}{}
+		for _, h := range r.Headings {
+			sqlString += "(?, ?, ?, ?, ?),"
+			v = append(v, lid, cid, h.Level, h.Text, h.SkipsLevel)
+		}
+		sqlString = sqlString[0 : len(sqlString)-1]
+		stmt, _ := ds.db.Prepare(sqlString)
+		defer stmt.Close()
+
+		_, err := stmt.Exec(v...)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n Headings: %+v\nError: %+v\n", cid, v, err)
+		}
+	}
+
+	if len(r.Extractions) > 0 {
+		sqlString := "INSERT INTO extractions (pagereport_id, crawl_id, name, value) values "
+		v := []interface{}{}
+		for _, e := range r.Extractions {
+			sqlString += "(?, ?, ?, ?),"
+			v = append(v, lid, cid, e.Name, e.Value)
+		}
+		sqlString = sqlString[0 : len(sqlString)-1]
+		stmt, _ := ds.db.Prepare(sqlString)
+		defer stmt.Close()
+
+		_, err := stmt.Exec(v...)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n Extractions: %+v\nError: %+v\n", cid, v, err)
+		}
+	}
+
+	if len(r.CustomIssues) > 0 {
+		sqlString := "INSERT INTO custom_issues (pagereport_id, crawl_id, name) values "
+		v := []interface{}{}
+		for _, name := range r.CustomIssues {
+			sqlString += "(?, ?, ?),"
+			v = append(v, lid, cid, name)
+		}
+		sqlString = sqlString[0 : len(sqlString)-1]
+		stmt, _ := ds.db.Prepare(sqlString)
+		defer stmt.Close()
+
+		_, err := stmt.Exec(v...)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n CustomIssues: %+v\nError: %+v\n", cid, v, err)
+		}
+	}
+
+	if r.SocialTags != (models.SocialTags{}) {
+		query := `
+			INSERT INTO social_tags (
+				pagereport_id,
+				crawl_id,
+				og_title,
+				og_description,
+				og_image,
+				og_image_url,
+				og_image_hash,
+				og_url,
+				og_type,
+				og_site_name,
+				twitter_card,
+				twitter_title,
+				twitter_description,
+				twitter_image
+			)
+			values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+		s := r.SocialTags
+		_, err := ds.db.Exec(
+			query,
+			lid,
+			cid,
+			s.OGTitle,
+			s.OGDescription,
+			s.OGImage,
+			s.OGImageURL,
+			Hash(s.OGImageURL),
+			s.OGURL,
+			s.OGType,
+			s.OGSiteName,
+			s.TwitterCard,
+			s.TwitterTitle,
+			s.TwitterDescription,
+			s.TwitterImage,
+		)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n SocialTags: %+v\nError: %+v\n", cid, s, err)
+		}
+	}
+
+	if r.PageSetup != (models.PageSetup{}) {
+		query := `
+			INSERT INTO page_setup (
+				pagereport_id,
+				crawl_id,
+				viewport,
+				meta_charset,
+				header_charset,
+				favicon,
+				apple_touch_icon,
+				theme_color
+			)
+			values (?, ?, ?, ?, ?, ?, ?, ?)`
+
+		s := r.PageSetup
+		_, err := ds.db.Exec(
+			query,
+			lid,
+			cid,
+			s.Viewport,
+			s.MetaCharset,
+			s.HeaderCharset,
+			s.Favicon,
+			s.AppleTouchIcon,
+			s.ThemeColor,
+		)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n PageSetup: %+v\nError: %+v\n", cid, s, err)
+		}
+	}
+
+	if !r.HeadElements.IsEmpty() {
+		query := `
+			INSERT INTO head_elements (
+				pagereport_id,
+				crawl_id,
+				breaking_element,
+				tags_after_break,
+				duplicate_ids,
+				titles,
+				canonicals
+			)
+			values (?, ?, ?, ?, ?, ?, ?)`
+
+		h := r.HeadElements
+		_, err := ds.db.Exec(
+			query,
+			lid,
+			cid,
+			h.BreakingElement,
+			strings.Join(h.TagsAfterBreak, " "),
+			strings.Join(h.DuplicateIds, " "),
+			h.Titles,
+			h.Canonicals,
+		)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n HeadElements: %+v\nError: %+v\n", cid, h, err)
+		}
+	}
+
+	if r.Accessibility != (models.Accessibility{}) {
+		query := `
+			INSERT INTO accessibility (
+				pagereport_id,
+				crawl_id,
+				inputs_without_label,
+				buttons_without_name,
+				links_without_name,
+				positive_tabindex,
+				iframes_without_title,
+				images_without_alt,
+				missing_lang
+			)
+			values (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+		a := r.Accessibility
+		_, err := ds.db.Exec(
+			query,
+			lid,
+			cid,
+			a.InputsWithoutLabel,
+			a.ButtonsWithoutName,
+			a.LinksWithoutName,
+			a.PositiveTabindex,
+			a.IframesWithoutTitle,
+			a.ImagesWithoutAlt,
+			a.MissingLang,
+		)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n Accessibility: %+v\nError: %+v\n", cid, a, err)
+		}
+	}
+
+	if r.CacheHeaders != (models.CacheHeaders{}) {
+		query := `
+			INSERT INTO cache_headers (
+				pagereport_id,
+				crawl_id,
+				cache_control,
+				expires,
+				age,
+				etag,
+				last_modified,
+				set_cookie,
+				max_age
+			)
+			values (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+		h := r.CacheHeaders
+		_, err := ds.db.Exec(
+			query,
+			lid,
+			cid,
+			h.CacheControl,
+			h.Expires,
+			h.Age,
+			h.ETag,
+			h.LastModified,
+			h.SetCookie,
+			h.MaxAge,
+		)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n CacheHeaders: %+v\nError: %+v\n", cid, h, err)
+		}
+	}
+
+	if r.SecurityHeaders != (models.SecurityHeaders{}) {
+		query := `
+			INSERT INTO security_headers (
+				pagereport_id,
+				crawl_id,
+				strict_transport_security,
+				content_security_policy,
+				x_frame_options,
+				x_content_type_options,
+				referrer_policy,
+				permissions_policy
+			)
+			values (?, ?, ?, ?, ?, ?, ?, ?)`
+
+		h := r.SecurityHeaders
+		_, err := ds.db.Exec(
+			query,
+			lid,
+			cid,
+			h.StrictTransportSecurity,
+			h.ContentSecurityPolicy,
+			h.XFrameOptions,
+			h.XContentTypeOptions,
+			h.ReferrerPolicy,
+			h.PermissionsPolicy,
+		)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n SecurityHeaders: %+v\nError: %+v\n", cid, h, err)
+		}
+	}
+
+	if r.PDF != (models.PDF{}) {
+		query := `
+			INSERT INTO pdf_documents (
+				pagereport_id,
+				crawl_id,
+				pages
+			)
+			values (?, ?, ?)`
+
+		_, err := ds.db.Exec(query, lid, cid, r.PDF.Pages)
+		if err != nil {
+			log.Printf("savePageReport\nCID: %v\n PDF: %+v\nError: %+v\n", cid, r.PDF, err)
+		}
+	}
+
 	r.Id = lid
 
 	return r, nil
@@ -287,7 +663,12 @@ func (ds *Datastore) FindAllPageReportsByCrawlId(cid int64) <-chan *models.PageR
 				robotstxt_blocked,
 				crawled,
 				in_sitemap,
-				valid_lang
+				valid_lang,
+				text_ratio,
+				sentences,
+				avg_sentence_length,
+				readability,
+				valid_readability
 			FROM pagereports
 			WHERE crawl_id = ?`
 
@@ -320,6 +701,11 @@ func (ds *Datastore) FindAllPageReportsByCrawlId(cid int64) <-chan *models.PageR
 				&p.Crawled,
 				&p.InSitemap,
 				&p.ValidLang,
+				&p.TextRatio,
+				&p.Sentences,
+				&p.AvgSentenceLength,
+				&p.Readability,
+				&p.ValidReadability,
 			)
 			if err != nil {
 				log.Println(err)
@@ -333,6 +719,62 @@ func (ds *Datastore) FindAllPageReportsByCrawlId(cid int64) <-chan *models.PageR
 	return prStream
 }
 
+// FindAllPageReportsWithLinksByCrawlId returns a stream with the crawl's page reports including
+// their internal links, so the issue reporters can be run again against an existing crawl.
+func (ds *Datastore) FindAllPageReportsWithLinksByCrawlId(cid int64) <-chan *models.PageReport {
+	prStream := make(chan *models.PageReport)
+
+	go func() {
+		defer close(prStream)
+
+		query := `
+			SELECT
+				url,
+				rel,
+				nofollow,
+				text,
+				position,
+				title,
+				blank_noopener,
+				image
+			FROM links
+			WHERE pagereport_id = ?`
+
+		for p := range ds.FindAllPageReportsByCrawlId(cid) {
+			rows, err := ds.db.Query(query, p.Id)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+
+			for rows.Next() {
+				l := models.Link{}
+				err := rows.Scan(
+					&l.URL,
+					&l.Rel,
+					&l.NoFollow,
+					&l.Text,
+					&l.Position,
+					&l.Title,
+					&l.BlankNoOpener,
+					&l.Image,
+				)
+				if err != nil {
+					log.Println(err)
+					continue
+				}
+
+				p.Links = append(p.Links, l)
+			}
+			rows.Close()
+
+			prStream <- p
+		}
+	}()
+
+	return prStream
+}
+
 func (ds *Datastore) FindAllPageReportsByCrawlIdAndErrorType(cid int64, et string) <-chan *models.PageReport {
 	prStream := make(chan *models.PageReport)
 
@@ -362,7 +804,12 @@ func (ds *Datastore) FindAllPageReportsByCrawlIdAndErrorType(cid int64, et strin
 				robotstxt_blocked,
 				crawled,
 				in_sitemap,
-				valid_lang
+				valid_lang,
+				text_ratio,
+				sentences,
+				avg_sentence_length,
+				readability,
+				valid_readability
 			FROM pagereports
 			WHERE crawl_id = ?
 			AND id IN (
@@ -370,7 +817,7 @@ func (ds *Datastore) FindAllPageReportsByCrawlIdAndErrorType(cid int64, et strin
 					pagereport_id
 				FROM issues
 				INNER JOIN issue_types ON issue_types.id = issues.issue_type_id
-				WHERE issue_types.type = ? AND crawl_id = ?
+				WHERE issue_types.type = ? AND crawl_id = ? AND issues.suppressed = 0
 			)`
 
 		rows, err := ds.db.Query(query, cid, et, cid)
@@ -402,6 +849,11 @@ func (ds *Datastore) FindAllPageReportsByCrawlIdAndErrorType(cid int64, et strin
 				&p.Crawled,
 				&p.InSitemap,
 				&p.ValidLang,
+				&p.TextRatio,
+				&p.Sentences,
+				&p.AvgSentenceLength,
+				&p.Readability,
+				&p.ValidReadability,
 			)
 			if err != nil {
 				log.Println(err)
@@ -439,7 +891,13 @@ func (ds *Datastore) FindPageReportById(rid int) models.PageReport {
 			robotstxt_blocked,
 			crawled,
 			in_sitemap,
-			valid_lang
+			valid_lang,
+			text_ratio,
+			sentences,
+			avg_sentence_length,
+			readability,
+			valid_readability,
+			robots_conflict
 		FROM pagereports
 		WHERE id = ?`
 
@@ -468,11 +926,33 @@ func (ds *Datastore) FindPageReportById(rid int) models.PageReport {
 		&p.Crawled,
 		&p.InSitemap,
 		&p.ValidLang,
+		&p.TextRatio,
+		&p.Sentences,
+		&p.AvgSentenceLength,
+		&p.Readability,
+		&p.ValidReadability,
+		&p.RobotsConflict,
 	)
 	if err != nil {
 		log.Println(err)
 	}
 
+	rdrows, err := ds.db.Query("SELECT source, bot, name, value FROM robots_directives WHERE pagereport_id = ? ORDER BY id", rid)
+	if err != nil {
+		log.Println(err)
+	}
+
+	for rdrows.Next() {
+		d := models.RobotsDirective{}
+		err = rdrows.Scan(&d.Source, &d.Bot, &d.Name, &d.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		p.RobotsDirectives = append(p.RobotsDirectives, d)
+	}
+
 	hrows, err := ds.db.Query("SELECT to_url, to_lang FROM hreflangs WHERE pagereport_id = ?", rid)
 	if err != nil {
 		log.Println(err)
@@ -489,14 +969,58 @@ func (ds *Datastore) FindPageReportById(rid int) models.PageReport {
 		p.Hreflangs = append(p.Hreflangs, h)
 	}
 
-	irows, err := ds.db.Query("SELECT url, alt FROM images WHERE pagereport_id = ?", rid)
+	crows, err := ds.db.Query("SELECT url, source FROM canonicals WHERE pagereport_id = ? ORDER BY id", rid)
+	if err != nil {
+		log.Println(err)
+	}
+
+	for crows.Next() {
+		c := models.Canonical{}
+		err = crows.Scan(&c.URL, &c.Source)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		p.Canonicals = append(p.Canonicals, c)
+	}
+
+	lrrows, err := ds.db.Query("SELECT rel, url, media FROM link_relations WHERE pagereport_id = ? ORDER BY id", rid)
+	if err != nil {
+		log.Println(err)
+	}
+
+	for lrrows.Next() {
+		l := models.LinkRelation{}
+		err = lrrows.Scan(&l.Rel, &l.URL, &l.Media)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		p.LinkRelations = append(p.LinkRelations, l)
+	}
+
+	iquery := `
+		SELECT
+			images.url,
+			images.alt,
+			images.width,
+			images.height,
+			images.srcset,
+			IFNULL(r.size, 0)
+		FROM images
+		LEFT JOIN pagereports r ON r.url_hash = images.url_hash AND r.crawl_id = images.crawl_id AND r.crawled = 1
+		WHERE images.pagereport_id = ?`
+
+	irows, err := ds.db.Query(iquery, rid)
 	if err != nil {
 		log.Println(err)
 	}
 
 	for irows.Next() {
 		i := models.Image{}
-		err = irows.Scan(&i.URL, &i.Alt)
+		err = irows.Scan(&i.URL, &i.Alt, &i.Width, &i.Height, &i.SrcSet, &i.Size)
 		if err != nil {
 			log.Println(err)
 			continue
@@ -585,6 +1109,208 @@ func (ds *Datastore) FindPageReportById(rid int) models.PageReport {
 		p.Styles = append(p.Styles, url)
 	}
 
+	hdrows, err := ds.db.Query("SELECT level, text, skips_level FROM headings WHERE pagereport_id = ? ORDER BY id", rid)
+	if err != nil {
+		log.Println(err)
+	}
+
+	for hdrows.Next() {
+		h := models.Heading{}
+		err = hdrows.Scan(&h.Level, &h.Text, &h.SkipsLevel)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		p.Headings = append(p.Headings, h)
+	}
+
+	exrows, err := ds.db.Query("SELECT name, value FROM extractions WHERE pagereport_id = ? ORDER BY id", rid)
+	if err != nil {
+		log.Println(err)
+	}
+
+	for exrows.Next() {
+		e := models.Extraction{}
+		err = exrows.Scan(&e.Name, &e.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		p.Extractions = append(p.Extractions, e)
+	}
+
+	query = `
+		SELECT
+			og_title,
+			og_description,
+			og_image,
+			og_image_url,
+			og_url,
+			og_type,
+			og_site_name,
+			twitter_card,
+			twitter_title,
+			twitter_description,
+			twitter_image
+		FROM social_tags
+		WHERE pagereport_id = ?`
+
+	s := &p.SocialTags
+	err = ds.db.QueryRow(query, rid).Scan(
+		&s.OGTitle,
+		&s.OGDescription,
+		&s.OGImage,
+		&s.OGImageURL,
+		&s.OGURL,
+		&s.OGType,
+		&s.OGSiteName,
+		&s.TwitterCard,
+		&s.TwitterTitle,
+		&s.TwitterDescription,
+		&s.TwitterImage,
+	)
+	if err != nil && err != sql.ErrNoRows {
+		log.Println(err)
+	}
+
+	query = `
+		SELECT
+			viewport,
+			meta_charset,
+			header_charset,
+			favicon,
+			apple_touch_icon,
+			theme_color
+		FROM page_setup
+		WHERE pagereport_id = ?`
+
+	ps := &p.PageSetup
+	err = ds.db.QueryRow(query, rid).Scan(
+		&ps.Viewport,
+		&ps.MetaCharset,
+		&ps.HeaderCharset,
+		&ps.Favicon,
+		&ps.AppleTouchIcon,
+		&ps.ThemeColor,
+	)
+	if err != nil && err != sql.ErrNoRows {
+		log.Println(err)
+	}
+
+	query = `
+		SELECT
+			breaking_element,
+			tags_after_break,
+			duplicate_ids,
+			titles,
+			canonicals
+		FROM head_elements
+		WHERE pagereport_id = ?`
+
+	var tagsAfterBreak, duplicateIds string
+	he := &p.HeadElements
+	err = ds.db.QueryRow(query, rid).Scan(
+		&he.BreakingElement,
+		&tagsAfterBreak,
+		&duplicateIds,
+		&he.Titles,
+		&he.Canonicals,
+	)
+	if err != nil && err != sql.ErrNoRows {
+		log.Println(err)
+	}
+
+	he.TagsAfterBreak = strings.Fields(tagsAfterBreak)
+	he.DuplicateIds = strings.Fields(duplicateIds)
+
+	query = `
+		SELECT
+			inputs_without_label,
+			buttons_without_name,
+			links_without_name,
+			positive_tabindex,
+			iframes_without_title,
+			images_without_alt,
+			missing_lang
+		FROM accessibility
+		WHERE pagereport_id = ?`
+
+	a := &p.Accessibility
+	err = ds.db.QueryRow(query, rid).Scan(
+		&a.InputsWithoutLabel,
+		&a.ButtonsWithoutName,
+		&a.LinksWithoutName,
+		&a.PositiveTabindex,
+		&a.IframesWithoutTitle,
+		&a.ImagesWithoutAlt,
+		&a.MissingLang,
+	)
+	if err != nil && err != sql.ErrNoRows {
+		log.Println(err)
+	}
+
+	query = `
+		SELECT
+			cache_control,
+			expires,
+			age,
+			etag,
+			last_modified,
+			set_cookie,
+			max_age
+		FROM cache_headers
+		WHERE pagereport_id = ?`
+
+	ch := &p.CacheHeaders
+	err = ds.db.QueryRow(query, rid).Scan(
+		&ch.CacheControl,
+		&ch.Expires,
+		&ch.Age,
+		&ch.ETag,
+		&ch.LastModified,
+		&ch.SetCookie,
+		&ch.MaxAge,
+	)
+	if err != nil && err != sql.ErrNoRows {
+		log.Println(err)
+	}
+
+	query = `
+		SELECT
+			strict_transport_security,
+			content_security_policy,
+			x_frame_options,
+			x_content_type_options,
+			referrer_policy,
+			permissions_policy
+		FROM security_headers
+		WHERE pagereport_id = ?`
+
+	sh := &p.SecurityHeaders
+	err = ds.db.QueryRow(query, rid).Scan(
+		&sh.StrictTransportSecurity,
+		&sh.ContentSecurityPolicy,
+		&sh.XFrameOptions,
+		&sh.XContentTypeOptions,
+		&sh.ReferrerPolicy,
+		&sh.PermissionsPolicy,
+	)
+	if err != nil && err != sql.ErrNoRows {
+		log.Println(err)
+	}
+
+	query = `
+		SELECT pages
+		FROM pdf_documents
+		WHERE pagereport_id = ?`
+
+	err = ds.db.QueryRow(query, rid).Scan(&p.PDF.Pages)
+	if err != nil && err != sql.ErrNoRows {
+		log.Println(err)
+	}
+
 	return p
 }
 
@@ -602,7 +1328,11 @@ func (ds *Datastore) FindLinks(pageReport *models.PageReport, cid int64, p int)
 			links.url,
 			links.rel,
 			links.nofollow,
-			links.text
+			links.text,
+			links.position,
+			links.title,
+			links.blank_noopener,
+			links.image
 		FROM links
 		LEFT JOIN pagereports ON links.url_hash = pagereports.url_hash
 		WHERE links.pagereport_id = ? and pagereports.crawl_id = ?
@@ -625,6 +1355,10 @@ func (ds *Datastore) FindLinks(pageReport *models.PageReport, cid int64, p int)
 			&l.Link.Rel,
 			&l.Link.NoFollow,
 			&l.Link.Text,
+			&l.Link.Position,
+			&l.Link.Title,
+			&l.Link.BlankNoOpener,
+			&l.Link.Image,
 		)
 		if err != nil {
 			log.Println(err)
@@ -644,14 +1378,21 @@ func (ds *Datastore) FindExternalLinks(pageReport *models.PageReport, cid int64,
 
 	query := `
 		SELECT
-			url,
-			rel,
-			nofollow,
-			text,
-			Sponsored,
-			UGC
+			external_links.url,
+			external_links.rel,
+			external_links.nofollow,
+			external_links.text,
+			external_links.sponsored,
+			external_links.ugc,
+			external_links.position,
+			external_links.title,
+			external_links.blank_noopener,
+			external_links.image,
+			IFNULL(external_link_status.status_code, 0)
 		FROM external_links
-		WHERE pagereport_id = ?
+		LEFT JOIN external_link_status ON external_link_status.url_hash = external_links.url_hash
+			AND external_link_status.crawl_id = external_links.crawl_id
+		WHERE external_links.pagereport_id = ?
 		LIMIT ?,?
 	`
 
@@ -662,7 +1403,19 @@ func (ds *Datastore) FindExternalLinks(pageReport *models.PageReport, cid int64,
 
 	for lrows.Next() {
 		l := models.Link{}
-		err = lrows.Scan(&l.URL, &l.Rel, &l.NoFollow, &l.Text, &l.Sponsored, &l.UGC)
+		err = lrows.Scan(
+			&l.URL,
+			&l.Rel,
+			&l.NoFollow,
+			&l.Text,
+			&l.Sponsored,
+			&l.UGC,
+			&l.Position,
+			&l.Title,
+			&l.BlankNoOpener,
+			&l.Image,
+			&l.StatusCode,
+		)
 		if err != nil {
 			log.Println(err)
 			continue
@@ -707,7 +1460,7 @@ func (ds *Datastore) FindSitemapPageReports(cid int64) <-chan *models.PageReport
 	return prStream
 }
 
-func (ds *Datastore) FindPageReportIssues(cid int64, p int, errorType string) []models.PageReport {
+func (ds *Datastore) FindPageReportIssues(cid int64, p int, errorType string, suppressed bool) []models.PageReport {
 	max := paginationMax
 	offset := max * (p - 1)
 
@@ -721,11 +1474,11 @@ func (ds *Datastore) FindPageReportIssues(cid int64, p int, errorType string) []
 			SELECT DISTINCT pagereport_id
 			FROM issues
 			INNER JOIN issue_types ON issue_types.id = issues.issue_type_id
-			WHERE issue_types.type = ? AND crawl_id = ?
+			WHERE issue_types.type = ? AND crawl_id = ? AND issues.suppressed = ?
 		) ORDER BY url ASC LIMIT ?, ?`
 
 	var pageReports []models.PageReport
-	rows, err := ds.db.Query(query, errorType, cid, offset, max)
+	rows, err := ds.db.Query(query, errorType, cid, suppressed, offset, max)
 	if err != nil {
 		log.Println(err)
 	}
@@ -744,7 +1497,19 @@ func (ds *Datastore) FindPageReportIssues(cid int64, p int, errorType string) []
 	return pageReports
 }
 
-func (ds *Datastore) FindPaginatedPageReports(cid int64, p int, term string) []models.PageReport {
+// Columns used to sort the paginated PageReports in the explorer.
+var pageReportSortColumns = map[string]string{
+	"url":                 "url ASC",
+	"words":               "words DESC",
+	"text_ratio":          "text_ratio ASC",
+	"sentences":           "sentences DESC",
+	"avg_sentence_length": "avg_sentence_length DESC",
+	"readability":         "valid_readability DESC, readability ASC",
+}
+
+// FindPaginatedPageReports returns a page of the crawled PageReports, optionally filtered by a search term.
+// The PageReports are sorted by the sort column, which defaults to the URL.
+func (ds *Datastore) FindPaginatedPageReports(cid int64, p int, term string, sort string) []models.PageReport {
 	max := paginationMax
 	offset := max * (p - 1)
 	args := []interface{}{term, cid}
@@ -755,6 +1520,12 @@ func (ds *Datastore) FindPaginatedPageReports(cid int64, p int, term string) []m
 			id,
 			url,
 			title,
+			words,
+			text_ratio,
+			sentences,
+			avg_sentence_length,
+			readability,
+			valid_readability,
 			(CASE WHEN url = ? THEN 1 ELSE 0 END) AS exact_match
 		FROM pagereports
 		WHERE crawl_id = ?
@@ -765,8 +1536,13 @@ func (ds *Datastore) FindPaginatedPageReports(cid int64, p int, term string) []m
 		args = append(args, term)
 	}
 
+	order, ok := pageReportSortColumns[sort]
+	if !ok {
+		order = pageReportSortColumns["url"]
+	}
+
 	query += `
-		ORDER BY exact_match DESC, url ASC
+		ORDER BY exact_match DESC, ` + order + `
 		LIMIT ?, ?`
 
 	args = append(args, offset, max)
@@ -780,7 +1556,18 @@ func (ds *Datastore) FindPaginatedPageReports(cid int64, p int, term string) []m
 	for rows.Next() {
 		var e bool
 		p := models.PageReport{}
-		err := rows.Scan(&p.Id, &p.URL, &p.Title, &e)
+		err := rows.Scan(
+			&p.Id,
+			&p.URL,
+			&p.Title,
+			&p.Words,
+			&p.TextRatio,
+			&p.Sentences,
+			&p.AvgSentenceLength,
+			&p.Readability,
+			&p.ValidReadability,
+			&e,
+		)
 		if err != nil {
 			log.Println(err)
 			continue
@@ -814,7 +1601,9 @@ func (ds *Datastore) GetNumberOfPagesForPageReport(cid int64, term string) int {
 	return int(math.Ceil(f))
 }
 
-func (ds *Datastore) FindInLinks(s string, cid int64, p int) []models.InternalLink {
+// FindInLinks returns a page of the internal links pointing to the URL. If position is not empty
+// only the links in that position of the page layout are returned.
+func (ds *Datastore) FindInLinks(s string, cid int64, p int, position string) []models.InternalLink {
 	max := paginationMax
 	offset := max * (p - 1)
 
@@ -825,21 +1614,36 @@ func (ds *Datastore) FindInLinks(s string, cid int64, p int) []models.InternalLi
 			pagereports.url,
 			pagereports.title,
 			links.nofollow,
-			links.text
+			links.text,
+			links.position,
+			links.title,
+			links.blank_noopener,
+			links.image
 		FROM links
 		LEFT JOIN pagereports ON pagereports.id = links.pagereport_id
 		WHERE links.url_hash = ? AND pagereports.crawl_id = ? AND pagereports.crawled = 1
+		AND (? = "" OR links.position = ?)
 		LIMIT ?,?`
 
 	var internalLinks []models.InternalLink
-	rows, err := ds.db.Query(query, hash, cid, offset, max)
+	rows, err := ds.db.Query(query, hash, cid, position, position, offset, max)
 	if err != nil {
 		log.Println(err)
 	}
 
 	for rows.Next() {
 		il := models.InternalLink{}
-		err := rows.Scan(&il.PageReport.Id, &il.PageReport.URL, &il.PageReport.Title, &il.Link.NoFollow, &il.Link.Text)
+		err := rows.Scan(
+			&il.PageReport.Id,
+			&il.PageReport.URL,
+			&il.PageReport.Title,
+			&il.Link.NoFollow,
+			&il.Link.Text,
+			&il.Link.Position,
+			&il.Link.Title,
+			&il.Link.BlankNoOpener,
+			&il.Link.Image,
+		)
 		if err != nil {
 			log.Println(err)
 			continue
@@ -918,7 +1722,7 @@ func (ds *Datastore) GetNumberOfPagesForExternalLinks(pageReport *models.PageRep
 	return int(math.Ceil(f))
 }
 
-func (ds *Datastore) GetNumberOfPagesForInlinks(pageReport *models.PageReport, cid int64) int {
+func (ds *Datastore) GetNumberOfPagesForInlinks(pageReport *models.PageReport, cid int64, position string) int {
 	h := Hash(pageReport.URL)
 	query := `
 		SELECT 
@@ -926,9 +1730,10 @@ func (ds *Datastore) GetNumberOfPagesForInlinks(pageReport *models.PageReport, c
 		FROM links
 		LEFT JOIN pagereports ON pagereports.id = links.pagereport_id
 		WHERE links.url_hash = ? AND pagereports.crawl_id = ? AND pagereports.crawled = 1
+		AND (? = "" OR links.position = ?)
 	`
 
-	row := ds.db.QueryRow(query, h, cid)
+	row := ds.db.QueryRow(query, h, cid, position, position)
 	var c int
 	if err := row.Scan(&c); err != nil {
 		log.Printf("GetNumberOfPagesForInlinks: %v\n", err)
@@ -19,9 +19,11 @@ func (ds *Datastore) SaveProject(project *models.Project, uid int) {
 			crawl_sitemap,
 			allow_subdomains,
 			basic_auth,
+			check_external_links,
+			check_readability,
 			user_id
 		)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	stmt, _ := ds.db.Prepare(query)
@@ -34,6 +36,8 @@ func (ds *Datastore) SaveProject(project *models.Project, uid int) {
 		project.CrawlSitemap,
 		project.AllowSubdomains,
 		project.BasicAuth,
+		project.CheckExternalLinks,
+		project.CheckReadability,
 		uid,
 	)
 	if err != nil {
@@ -53,6 +57,16 @@ func (ds *Datastore) FindProjectsByUser(uid int) []models.Project {
 			crawl_sitemap,
 			allow_subdomains,
 			basic_auth,
+			check_external_links,
+			duplicate_threshold,
+			max_image_size,
+			check_readability,
+			title_min_length,
+			title_max_length,
+			description_min_length,
+			description_max_length,
+			max_links,
+			min_words,
 			deleting,
 			created
 		FROM projects
@@ -76,6 +90,16 @@ func (ds *Datastore) FindProjectsByUser(uid int) []models.Project {
 			&p.CrawlSitemap,
 			&p.AllowSubdomains,
 			&p.BasicAuth,
+			&p.CheckExternalLinks,
+			&p.Thresholds.DuplicateThreshold,
+			&p.Thresholds.MaxImageSize,
+			&p.CheckReadability,
+			&p.Thresholds.TitleMinLength,
+			&p.Thresholds.TitleMaxLength,
+			&p.Thresholds.DescriptionMinLength,
+			&p.Thresholds.DescriptionMaxLength,
+			&p.Thresholds.MaxLinks,
+			&p.Thresholds.MinWords,
 			&p.Deleting,
 			&p.Created,
 		)
@@ -101,6 +125,16 @@ func (ds *Datastore) FindProjectById(id int, uid int) (models.Project, error) {
 			crawl_sitemap,
 			allow_subdomains,
 			basic_auth,
+			check_external_links,
+			duplicate_threshold,
+			max_image_size,
+			check_readability,
+			title_min_length,
+			title_max_length,
+			description_min_length,
+			description_max_length,
+			max_links,
+			min_words,
 			deleting,
 			created
 		FROM projects
@@ -118,6 +152,16 @@ func (ds *Datastore) FindProjectById(id int, uid int) (models.Project, error) {
 		&p.CrawlSitemap,
 		&p.AllowSubdomains,
 		&p.BasicAuth,
+		&p.CheckExternalLinks,
+		&p.Thresholds.DuplicateThreshold,
+		&p.Thresholds.MaxImageSize,
+		&p.CheckReadability,
+		&p.Thresholds.TitleMinLength,
+		&p.Thresholds.TitleMaxLength,
+		&p.Thresholds.DescriptionMinLength,
+		&p.Thresholds.DescriptionMaxLength,
+		&p.Thresholds.MaxLinks,
+		&p.Thresholds.MinWords,
 		&p.Deleting,
 		&p.Created,
 	)
@@ -212,6 +256,7 @@ func (ds *Datastore) GetLastCrawl(p *models.Project) models.Crawl {
 			critical_issues,
 			alert_issues,
 			warning_issues,
+			accessibility_issues,
 			issues_end,
 			robotstxt_exists,
 			sitemap_exists,
@@ -237,6 +282,7 @@ func (ds *Datastore) GetLastCrawl(p *models.Project) models.Crawl {
 		&crawl.CriticalIssues,
 		&crawl.AlertIssues,
 		&crawl.WarningIssues,
+		&crawl.AccessibilityIssues,
 		&crawl.IssuesEnd,
 		&crawl.RobotstxtExists,
 		&crawl.SitemapExists,
@@ -266,6 +312,7 @@ func (ds *Datastore) GetLastCrawls(p models.Project, limit int) []models.Crawl {
 			critical_issues,
 			alert_issues,
 			warning_issues,
+			accessibility_issues,
 			blocked_by_robotstxt,
 			noindex
 		FROM crawls
@@ -290,6 +337,7 @@ func (ds *Datastore) GetLastCrawls(p models.Project, limit int) []models.Crawl {
 			&crawl.CriticalIssues,
 			&crawl.AlertIssues,
 			&crawl.WarningIssues,
+			&crawl.AccessibilityIssues,
 			&crawl.BlockedByRobotstxt,
 			&crawl.Noindex,
 		)
@@ -302,21 +350,22 @@ func (ds *Datastore) GetLastCrawls(p models.Project, limit int) []models.Crawl {
 	return crawls
 }
 
-func (ds *Datastore) SaveIssuesCount(crawlId int64, critical, alert, warning int) {
+func (ds *Datastore) SaveIssuesCount(crawlId int64, critical, alert, warning, accessibility int) {
 	query := `UPDATE
 		crawls
 		SET 
 			critical_issues = ?,
 			alert_issues = ?,
 			warning_issues = ?,
+			accessibility_issues = ?,
 			total_issues = ?
 		WHERE id = ?`
 
 	stmt, _ := ds.db.Prepare(query)
 	defer stmt.Close()
 
-	total := critical + alert + warning
-	_, err := stmt.Exec(critical, alert, warning, total, crawlId)
+	total := critical + alert + warning + accessibility
+	_, err := stmt.Exec(critical, alert, warning, accessibility, total, crawlId)
 	if err != nil {
 		log.Printf("SaveIssuesCount: %v\n", err)
 	}
@@ -350,7 +399,17 @@ func (ds *Datastore) UpdateProject(p *models.Project) error {
 			include_noindex = ?,
 			crawl_sitemap = ?,
 			allow_subdomains = ?,
-			basic_auth = ?
+			basic_auth = ?,
+			check_external_links = ?,
+			duplicate_threshold = ?,
+			max_image_size = ?,
+			check_readability = ?,
+			title_min_length = ?,
+			title_max_length = ?,
+			description_min_length = ?,
+			description_max_length = ?,
+			max_links = ?,
+			min_words = ?
 		WHERE id = ?
 	`
 	_, err := ds.db.Exec(
@@ -361,6 +420,16 @@ func (ds *Datastore) UpdateProject(p *models.Project) error {
 		p.CrawlSitemap,
 		p.AllowSubdomains,
 		p.BasicAuth,
+		p.CheckExternalLinks,
+		p.Thresholds.DuplicateThreshold,
+		p.Thresholds.MaxImageSize,
+		p.CheckReadability,
+		p.Thresholds.TitleMinLength,
+		p.Thresholds.TitleMaxLength,
+		p.Thresholds.DescriptionMinLength,
+		p.Thresholds.DescriptionMaxLength,
+		p.Thresholds.MaxLinks,
+		p.Thresholds.MinWords,
 		p.Id,
 	)
 	if err != nil {
@@ -382,6 +451,7 @@ func (ds *Datastore) GetPreviousCrawl(p *models.Project) (*models.Crawl, error)
 			critical_issues,
 			alert_issues,
 			warning_issues,
+			accessibility_issues,
 			blocked_by_robotstxt,
 			noindex
 		FROM crawls
@@ -402,6 +472,7 @@ func (ds *Datastore) GetPreviousCrawl(p *models.Project) (*models.Crawl, error)
 		&crawl.CriticalIssues,
 		&crawl.AlertIssues,
 		&crawl.WarningIssues,
+		&crawl.AccessibilityIssues,
 		&crawl.BlockedByRobotstxt,
 		&crawl.Noindex,
 	)
@@ -438,6 +509,7 @@ func (ds *Datastore) DeleteCrawl(crawl *models.Crawl) {
 
 	deleteFunc(crawl.Id, "links")
 	deleteFunc(crawl.Id, "external_links")
+	deleteFunc(crawl.Id, "external_link_status")
 	deleteFunc(crawl.Id, "hreflangs")
 	deleteFunc(crawl.Id, "issues")
 	deleteFunc(crawl.Id, "images")
@@ -446,6 +518,23 @@ func (ds *Datastore) DeleteCrawl(crawl *models.Crawl) {
 	deleteFunc(crawl.Id, "iframes")
 	deleteFunc(crawl.Id, "audios")
 	deleteFunc(crawl.Id, "videos")
+	deleteFunc(crawl.Id, "structured_data")
+	deleteFunc(crawl.Id, "social_tags")
+	deleteFunc(crawl.Id, "headings")
+	deleteFunc(crawl.Id, "duplicate_canonicals")
+	deleteFunc(crawl.Id, "extractions")
+	deleteFunc(crawl.Id, "custom_issues")
+	deleteFunc(crawl.Id, "link_relations")
+	deleteFunc(crawl.Id, "page_setup")
+	deleteFunc(crawl.Id, "head_elements")
+	deleteFunc(crawl.Id, "accessibility")
+	deleteFunc(crawl.Id, "keywords")
+	deleteFunc(crawl.Id, "site_keywords")
+	deleteFunc(crawl.Id, "pdf_documents")
+	deleteFunc(crawl.Id, "canonicals")
+	deleteFunc(crawl.Id, "robots_directives")
+	deleteFunc(crawl.Id, "security_headers")
+	deleteFunc(crawl.Id, "cache_headers")
 	deleteFunc(crawl.Id, "pagereports")
 }
 
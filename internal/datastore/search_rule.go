@@ -0,0 +1,152 @@
+package datastore
+
+import (
+	"log"
+	"math"
+
+	"github.com/stjudewashere/seonaut/internal/issue"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// FindSearchRules returns the custom search rules of a project.
+func (ds *Datastore) FindSearchRules(pid int64) []models.SearchRule {
+	rules := []models.SearchRule{}
+	query := `
+		SELECT
+			id,
+			project_id,
+			name,
+			pattern,
+			type,
+			mode,
+			target
+		FROM search_rules
+		WHERE project_id = ?
+		ORDER BY id`
+
+	rows, err := ds.db.Query(query, pid)
+	if err != nil {
+		log.Println(err)
+		return rules
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		r := models.SearchRule{}
+		err := rows.Scan(&r.Id, &r.ProjectId, &r.Name, &r.Pattern, &r.Type, &r.Mode, &r.Target)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		rules = append(rules, r)
+	}
+
+	return rules
+}
+
+// SaveSearchRule stores a new custom search rule.
+func (ds *Datastore) SaveSearchRule(r *models.SearchRule) error {
+	query := "INSERT INTO search_rules (project_id, name, pattern, type, mode, target) VALUES (?, ?, ?, ?, ?, ?)"
+	_, err := ds.db.Exec(query, r.ProjectId, r.Name, r.Pattern, r.Type, r.Mode, r.Target)
+	if err != nil {
+		log.Printf("SaveSearchRule: %v\n", err)
+	}
+
+	return err
+}
+
+// DeleteSearchRule deletes a project's custom search rule.
+func (ds *Datastore) DeleteSearchRule(id, pid int64) {
+	_, err := ds.db.Exec("DELETE FROM search_rules WHERE id = ? AND project_id = ?", id, pid)
+	if err != nil {
+		log.Printf("DeleteSearchRule: %v\n", err)
+	}
+}
+
+// FindCustomIssues returns the custom issues of a crawl with the number of pages affected by each one.
+func (ds *Datastore) FindCustomIssues(cid int64) []issue.IssueGroup {
+	issues := []issue.IssueGroup{}
+	query := `
+		SELECT
+			name,
+			count(DISTINCT pagereport_id) AS c
+		FROM custom_issues
+		WHERE crawl_id = ?
+		GROUP BY name
+		ORDER BY c DESC`
+
+	rows, err := ds.db.Query(query, cid)
+	if err != nil {
+		log.Println(err)
+		return issues
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ig := issue.IssueGroup{}
+		if err := rows.Scan(&ig.ErrorType, &ig.Count); err != nil {
+			log.Println(err)
+			continue
+		}
+
+		issues = append(issues, ig)
+	}
+
+	return issues
+}
+
+// GetNumberOfPagesForCustomIssue returns the number of pages needed to paginate the PageReports
+// with a custom issue.
+func (ds *Datastore) GetNumberOfPagesForCustomIssue(cid int64, name string) int {
+	query := `
+		SELECT count(DISTINCT pagereport_id)
+		FROM custom_issues
+		WHERE name = ? AND crawl_id = ?`
+
+	row := ds.db.QueryRow(query, name, cid)
+	var c int
+	if err := row.Scan(&c); err != nil {
+		log.Printf("GetNumberOfPagesForCustomIssue: %v\n", err)
+	}
+	var f float64 = float64(c) / float64(paginationMax)
+	return int(math.Ceil(f))
+}
+
+// FindPageReportsByCustomIssue returns a page of the PageReports with a custom issue.
+func (ds *Datastore) FindPageReportsByCustomIssue(cid int64, p int, name string) []models.PageReport {
+	max := paginationMax
+	offset := max * (p - 1)
+
+	query := `
+		SELECT
+			id,
+			url,
+			title
+		FROM pagereports
+		WHERE id IN (
+			SELECT DISTINCT pagereport_id
+			FROM custom_issues
+			WHERE name = ? AND crawl_id = ?
+		) ORDER BY url ASC LIMIT ?, ?`
+
+	pageReports := []models.PageReport{}
+	rows, err := ds.db.Query(query, name, cid, offset, max)
+	if err != nil {
+		log.Println(err)
+		return pageReports
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		p := models.PageReport{}
+		if err := rows.Scan(&p.Id, &p.URL, &p.Title); err != nil {
+			log.Println(err)
+			continue
+		}
+
+		pageReports = append(pageReports, p)
+	}
+
+	return pageReports
+}
@@ -0,0 +1,60 @@
+package datastore
+
+import (
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/report"
+)
+
+// CountSecurityHeadersByHost returns, for each host in the crawl, the number of successful HTML
+// pages and how many of them send each of the security headers. Hosts with more pages go first.
+func (ds *Datastore) CountSecurityHeadersByHost(cid int64) *report.SecurityHeadersCount {
+	query := `
+		SELECT
+			SUBSTRING_INDEX(SUBSTRING_INDEX(pagereports.url, "/", 3), "/", -1) AS host,
+			COUNT(*),
+			COUNT(NULLIF(security_headers.strict_transport_security, "")),
+			COUNT(NULLIF(security_headers.content_security_policy, "")),
+			COUNT(NULLIF(security_headers.x_frame_options, "")),
+			COUNT(NULLIF(security_headers.x_content_type_options, "")),
+			COUNT(NULLIF(security_headers.referrer_policy, "")),
+			COUNT(NULLIF(security_headers.permissions_policy, ""))
+		FROM pagereports
+		LEFT JOIN security_headers ON security_headers.pagereport_id = pagereports.id
+		WHERE pagereports.crawl_id = ? AND pagereports.media_type = "text/html"
+			AND pagereports.status_code >= 200 AND pagereports.status_code < 300
+			AND pagereports.crawled = 1
+		GROUP BY host
+		ORDER BY COUNT(*) DESC, host`
+
+	c := report.SecurityHeadersCount{}
+
+	rows, err := ds.db.Query(query, cid)
+	if err != nil {
+		log.Println(err)
+		return &c
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		h := report.HostSecurityHeaders{}
+		err := rows.Scan(
+			&h.Host,
+			&h.Pages,
+			&h.StrictTransportSecurity,
+			&h.ContentSecurityPolicy,
+			&h.XFrameOptions,
+			&h.XContentTypeOptions,
+			&h.ReferrerPolicy,
+			&h.PermissionsPolicy,
+		)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		c = append(c, h)
+	}
+
+	return &c
+}
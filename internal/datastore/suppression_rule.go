@@ -0,0 +1,129 @@
+package datastore
+
+import (
+	"log"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/issue"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// FindSuppressionRules returns the issue suppression rules of a project.
+func (ds *Datastore) FindSuppressionRules(pid int64) []models.SuppressionRule {
+	rules := []models.SuppressionRule{}
+	query := `
+		SELECT
+			id,
+			project_id,
+			issue_type,
+			url_pattern
+		FROM suppression_rules
+		WHERE project_id = ?
+		ORDER BY id`
+
+	rows, err := ds.db.Query(query, pid)
+	if err != nil {
+		log.Println(err)
+		return rules
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		r := models.SuppressionRule{}
+		err := rows.Scan(&r.Id, &r.ProjectId, &r.IssueType, &r.URLPattern)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		rules = append(rules, r)
+	}
+
+	return rules
+}
+
+// SaveSuppressionRule stores a new issue suppression rule.
+func (ds *Datastore) SaveSuppressionRule(r *models.SuppressionRule) error {
+	query := "INSERT INTO suppression_rules (project_id, issue_type, url_pattern) VALUES (?, ?, ?)"
+	_, err := ds.db.Exec(query, r.ProjectId, r.IssueType, r.URLPattern)
+	if err != nil {
+		log.Printf("SaveSuppressionRule: %v\n", err)
+	}
+
+	return err
+}
+
+// DeleteSuppressionRule deletes a project's issue suppression rule.
+func (ds *Datastore) DeleteSuppressionRule(id, pid int64) {
+	_, err := ds.db.Exec("DELETE FROM suppression_rules WHERE id = ? AND project_id = ?", id, pid)
+	if err != nil {
+		log.Printf("DeleteSuppressionRule: %v\n", err)
+	}
+}
+
+// SuppressIssues marks the crawl's issues matching any of the suppression rules as suppressed.
+// The rest of the crawl's issues are marked as not suppressed.
+func (ds *Datastore) SuppressIssues(cid int64, rules []models.SuppressionRule) {
+	_, err := ds.db.Exec("UPDATE issues SET suppressed = 0 WHERE crawl_id = ? AND suppressed = 1", cid)
+	if err != nil {
+		log.Printf("SuppressIssues: cid %d %v\n", cid, err)
+		return
+	}
+
+	query := `
+		UPDATE issues
+		INNER JOIN issue_types ON issue_types.id = issues.issue_type_id
+		INNER JOIN pagereports ON pagereports.id = issues.pagereport_id
+		SET issues.suppressed = 1
+		WHERE issues.crawl_id = ? AND issue_types.type = ? AND pagereports.url LIKE ?`
+
+	for _, r := range rules {
+		_, err := ds.db.Exec(query, cid, r.IssueType, LikePattern(r.URLPattern))
+		if err != nil {
+			log.Printf("SuppressIssues: cid %d rule %d %v\n", cid, r.Id, err)
+		}
+	}
+}
+
+// FindSuppressedIssues returns the suppressed issues of a crawl with the number of pages
+// affected by each issue type.
+func (ds *Datastore) FindSuppressedIssues(cid int64) []issue.IssueGroup {
+	issues := []issue.IssueGroup{}
+	query := `
+		SELECT
+			issue_types.type,
+			issue_types.priority,
+			count(DISTINCT issues.pagereport_id) AS c
+		FROM issues
+		INNER JOIN issue_types ON issue_types.id = issues.issue_type_id
+		WHERE crawl_id = ? AND issues.suppressed = 1
+		GROUP BY issue_type_id
+		ORDER BY c DESC`
+
+	rows, err := ds.db.Query(query, cid)
+	if err != nil {
+		log.Println(err)
+		return issues
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ig := issue.IssueGroup{}
+		if err := rows.Scan(&ig.ErrorType, &ig.Priority, &ig.Count); err != nil {
+			log.Println(err)
+			continue
+		}
+
+		issues = append(issues, ig)
+	}
+
+	return issues
+}
+
+// LikePattern returns a SQL LIKE pattern matching any string that contains the URL pattern.
+// The LIKE special characters are escaped and the "*" wildcard is replaced by "%".
+func LikePattern(pattern string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `*`, `%`)
+
+	return "%" + r.Replace(pattern) + "%"
+}
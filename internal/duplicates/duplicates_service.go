@@ -0,0 +1,128 @@
+package duplicates
+
+import (
+	"errors"
+
+	"github.com/stjudewashere/seonaut/internal/fingerprint"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type DuplicatesStore interface {
+	FindDuplicatePages(crawlId int64) []models.DuplicatePage
+	FindDuplicateClusterPages(crawlId int64) []models.DuplicatePage
+	SaveDuplicateClusters(crawlId int64, clusters [][]int64, canonicals []int64) error
+	SaveDuplicateCanonical(crawlId int64, cluster []int64, pageReportId int64) error
+}
+
+type Service struct {
+	store DuplicatesStore
+}
+
+// Cluster is a group of pages with duplicate or near duplicate content.
+// Exact is true if all the pages have exactly the same content, and Similarity
+// is the lowest similarity percentage between the first page and the rest of the pages.
+type Cluster struct {
+	Pages      []models.DuplicatePage
+	Exact      bool
+	Similarity int
+	Canonical  *models.PageReport
+}
+
+func NewService(s DuplicatesStore) *Service {
+	return &Service{
+		store: s,
+	}
+}
+
+// BuildClusters groups the crawl's pages with duplicate or near duplicate content in clusters,
+// using the similarity threshold to decide which pages are near duplicates, and stores them.
+// It must be called once the crawl has ended and before the duplicate content issues are created.
+// A previously selected canonical page is kept only if it is the only one selected in its new cluster.
+func (s *Service) BuildClusters(crawl *models.Crawl, threshold int) error {
+	pages := s.store.FindDuplicatePages(crawl.Id)
+
+	fps := make([]fingerprint.Fingerprint, len(pages))
+	for i, p := range pages {
+		fps[i] = fingerprint.Fingerprint{Hash: p.PageReport.ContentHash, SimHash: p.PageReport.SimHash}
+	}
+
+	clusters := [][]int64{}
+	canonicals := []int64{}
+	for _, indexes := range fingerprint.Cluster(fps, threshold) {
+		ids := []int64{}
+		selected := []int64{}
+		for _, i := range indexes {
+			ids = append(ids, pages[i].PageReport.Id)
+			if pages[i].Canonical {
+				selected = append(selected, pages[i].PageReport.Id)
+			}
+		}
+
+		clusters = append(clusters, ids)
+		if len(selected) == 1 {
+			canonicals = append(canonicals, selected[0])
+		}
+	}
+
+	return s.store.SaveDuplicateClusters(crawl.Id, clusters, canonicals)
+}
+
+// GetClusters returns the stored clusters of pages with duplicate or near duplicate content in a crawl.
+func (s *Service) GetClusters(crawlId int64) []Cluster {
+	clusters := []Cluster{}
+
+	var c *Cluster
+	var first models.PageReport
+	for _, p := range s.store.FindDuplicateClusterPages(crawlId) {
+		if c == nil || p.Cluster != c.Pages[0].Cluster {
+			if c != nil {
+				clusters = append(clusters, *c)
+			}
+
+			c = &Cluster{Exact: true, Similarity: 100}
+			first = p.PageReport
+		}
+
+		c.Pages = append(c.Pages, p)
+
+		if p.PageReport.ContentHash != first.ContentHash {
+			c.Exact = false
+			similarity := fingerprint.Similarity(first.SimHash, p.PageReport.SimHash)
+			if similarity < c.Similarity {
+				c.Similarity = similarity
+			}
+		}
+
+		if p.Canonical {
+			pr := p.PageReport
+			c.Canonical = &pr
+		}
+	}
+
+	if c != nil {
+		clusters = append(clusters, *c)
+	}
+
+	return clusters
+}
+
+// SetCanonical selects the PageReport with the pageReportId as the canonical URL of its cluster.
+// It returns an error if the PageReport is not part of any duplicate content cluster.
+func (s *Service) SetCanonical(crawlId int64, pageReportId int64) error {
+	for _, c := range s.GetClusters(crawlId) {
+		ids := []int64{}
+		found := false
+		for _, p := range c.Pages {
+			ids = append(ids, p.PageReport.Id)
+			if p.PageReport.Id == pageReportId {
+				found = true
+			}
+		}
+
+		if found {
+			return s.store.SaveDuplicateCanonical(crawlId, ids, pageReportId)
+		}
+	}
+
+	return errors.New("page report is not in a duplicate content cluster")
+}
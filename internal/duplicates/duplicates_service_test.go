@@ -0,0 +1,162 @@
+package duplicates_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/duplicates"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+const crawlId = 1
+
+type storage struct {
+	clusters   [][]int64
+	canonicals []int64
+	canonical  int64
+	cluster    []int64
+	selected   int64 // Additional page report selected as canonical
+}
+
+func (s *storage) FindDuplicatePages(crawlId int64) []models.DuplicatePage {
+	pages := []models.DuplicatePage{
+		{PageReport: models.PageReport{Id: 1, ContentHash: "a", SimHash: 0xFFFFFFFFFFFFFFFF}},
+		{PageReport: models.PageReport{Id: 2, ContentHash: "a", SimHash: 0xFFFFFFFFFFFFFFFF}, Canonical: true},
+		{PageReport: models.PageReport{Id: 3, ContentHash: "b", SimHash: 0xFFFFFFFFFFFFFFF0}},
+		{PageReport: models.PageReport{Id: 4, ContentHash: "c", SimHash: 0}},
+	}
+
+	for i := range pages {
+		if pages[i].PageReport.Id == s.selected {
+			pages[i].Canonical = true
+		}
+	}
+
+	return pages
+}
+
+func (s *storage) FindDuplicateClusterPages(crawlId int64) []models.DuplicatePage {
+	pages := []models.DuplicatePage{}
+	for i, cluster := range s.clusters {
+		for _, id := range cluster {
+			for _, p := range s.FindDuplicatePages(crawlId) {
+				if p.PageReport.Id == id {
+					p.Cluster = i + 1
+					pages = append(pages, p)
+				}
+			}
+		}
+	}
+
+	return pages
+}
+
+func (s *storage) SaveDuplicateClusters(crawlId int64, clusters [][]int64, canonicals []int64) error {
+	s.clusters = clusters
+	s.canonicals = canonicals
+
+	return nil
+}
+
+func (s *storage) SaveDuplicateCanonical(crawlId int64, cluster []int64, pageReportId int64) error {
+	s.cluster = cluster
+	s.canonical = pageReportId
+
+	return nil
+}
+
+func TestBuildClusters(t *testing.T) {
+	s := &storage{}
+	service := duplicates.NewService(s)
+
+	if err := service.BuildClusters(&models.Crawl{Id: crawlId}, 90); err != nil {
+		t.Fatalf("BuildClusters: %v", err)
+	}
+
+	if len(s.clusters) != 1 || len(s.clusters[0]) != 3 {
+		t.Errorf("Expected one cluster with three pages: %v", s.clusters)
+	}
+
+	if err := service.BuildClusters(&models.Crawl{Id: crawlId}, 100); err != nil {
+		t.Fatalf("BuildClusters: %v", err)
+	}
+
+	if len(s.clusters) != 1 || len(s.clusters[0]) != 2 {
+		t.Errorf("Expected one exact cluster with two pages: %v", s.clusters)
+	}
+
+	if len(s.canonicals) != 1 || s.canonicals[0] != 2 {
+		t.Errorf("Expected page report 2 to be kept as canonical: %v", s.canonicals)
+	}
+}
+
+// Test the selected canonical pages are removed if they are no longer in a cluster
+// or if they end up in the same cluster as another selected canonical page.
+func TestBuildClustersCanonicals(t *testing.T) {
+	s := &storage{selected: 4}
+	service := duplicates.NewService(s)
+
+	if err := service.BuildClusters(&models.Crawl{Id: crawlId}, 90); err != nil {
+		t.Fatalf("BuildClusters: %v", err)
+	}
+
+	if len(s.canonicals) != 1 || s.canonicals[0] != 2 {
+		t.Errorf("Expected only page report 2 to be kept as canonical: %v", s.canonicals)
+	}
+
+	s.selected = 3
+	if err := service.BuildClusters(&models.Crawl{Id: crawlId}, 90); err != nil {
+		t.Fatalf("BuildClusters: %v", err)
+	}
+
+	if len(s.canonicals) != 0 {
+		t.Errorf("Expected no canonical in a cluster with two selected pages: %v", s.canonicals)
+	}
+}
+
+func TestGetClusters(t *testing.T) {
+	service := duplicates.NewService(&storage{clusters: [][]int64{{1, 2, 3}}})
+
+	clusters := service.GetClusters(crawlId)
+	if len(clusters) != 1 {
+		t.Fatalf("Clusters: %d != 1", len(clusters))
+	}
+
+	c := clusters[0]
+	if len(c.Pages) != 3 {
+		t.Errorf("Cluster pages: %d != 3", len(c.Pages))
+	}
+
+	if c.Exact {
+		t.Error("Cluster should not be exact")
+	}
+
+	if c.Similarity != 93 {
+		t.Errorf("Cluster similarity: %d != 93", c.Similarity)
+	}
+
+	if c.Canonical == nil || c.Canonical.Id != 2 {
+		t.Errorf("Cluster canonical should be page report 2")
+	}
+
+	exact := duplicates.NewService(&storage{clusters: [][]int64{{1, 2}}}).GetClusters(crawlId)
+	if len(exact) != 1 || !exact[0].Exact || len(exact[0].Pages) != 2 {
+		t.Errorf("Expected one exact cluster with two pages: %+v", exact)
+	}
+}
+
+func TestSetCanonical(t *testing.T) {
+	s := &storage{clusters: [][]int64{{1, 2, 3}}}
+	service := duplicates.NewService(s)
+
+	if err := service.SetCanonical(crawlId, 3); err != nil {
+		t.Errorf("SetCanonical: %v", err)
+	}
+
+	if s.canonical != 3 || len(s.cluster) != 3 {
+		t.Errorf("SetCanonical: canonical %d cluster %v", s.canonical, s.cluster)
+	}
+
+	if err := service.SetCanonical(crawlId, 4); err == nil {
+		t.Error("SetCanonical: page report not in a cluster should return error")
+	}
+}
@@ -11,14 +11,17 @@ import (
 )
 
 type CSVWriter struct {
-	writer *csv.Writer
+	writer          *csv.Writer
+	extractionNames []string
 }
 
-func NewCSVWriter(f io.Writer) *CSVWriter {
-	cw := CSVWriter{}
+// NewCSVWriter returns a CSVWriter and writes the header row. The extractionNames are the names
+// of the custom extractors, which are added as extra columns after the PageReport columns.
+func NewCSVWriter(f io.Writer, extractionNames []string) *CSVWriter {
+	cw := CSVWriter{extractionNames: extractionNames}
 	cw.writer = csv.NewWriter(f)
 
-	cw.writer.Write([]string{
+	header := []string{
 		"Status Code",
 		"URL",
 		"Redirect URL",
@@ -34,13 +37,19 @@ func NewCSVWriter(f io.Writer) *CSVWriter {
 		"Header 2",
 		"Size",
 		"Nº of words",
-	})
+		"Text to HTML Ratio",
+		"Sentences",
+		"Average Sentence Length",
+		"Readability",
+	}
+
+	cw.writer.Write(append(header, extractionNames...))
 
 	return &cw
 }
 
 func (cw *CSVWriter) Write(r *models.PageReport) {
-	cw.writer.Write([]string{
+	row := []string{
 		fmt.Sprintf("%d", r.StatusCode),
 		r.URL,
 		r.RedirectURL,
@@ -56,8 +65,17 @@ func (cw *CSVWriter) Write(r *models.PageReport) {
 		r.H2,
 		fmt.Sprintf("%.1f KB", byteToKByte(r.Size)),
 		strconv.Itoa(r.Words),
-	})
+		fmt.Sprintf("%.1f%%", r.TextRatio),
+		strconv.Itoa(r.Sentences),
+		fmt.Sprintf("%.1f", r.AvgSentenceLength),
+		readability(r),
+	}
+
+	for _, name := range cw.extractionNames {
+		row = append(row, extraction(r, name))
+	}
 
+	cw.writer.Write(row)
 	cw.writer.Flush()
 }
 
@@ -67,3 +85,25 @@ func byteToKByte(b int) float64 {
 
 	return float64(v) + float64(r)/float64(1<<10)
 }
+
+// Returns the readability score of the PageReport, or an empty string
+// if there is no readability formula for the page's language.
+func readability(r *models.PageReport) string {
+	if !r.ValidReadability {
+		return ""
+	}
+
+	return fmt.Sprintf("%.0f", r.Readability)
+}
+
+// Returns the value extracted by the extractor with the given name,
+// or an empty string if it didn't extract any value from the PageReport.
+func extraction(r *models.PageReport, name string) string {
+	for _, e := range r.Extractions {
+		if e.Name == name {
+			return e.Value
+		}
+	}
+
+	return ""
+}
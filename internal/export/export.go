@@ -3,6 +3,7 @@ package export
 import (
 	"encoding/csv"
 	"io"
+	"strconv"
 
 	"github.com/stjudewashere/seonaut/internal/models"
 )
@@ -51,6 +52,30 @@ type Hreflang struct {
 	HreflangLang string
 }
 
+type BrokenResource struct {
+	Origin     string
+	Resource   string
+	StatusCode int
+}
+
+type StructuredData struct {
+	Origin     string
+	Format     string
+	Type       string
+	Properties string
+	Valid      bool
+}
+
+type Keyword struct {
+	Origin  string
+	Term    string
+	Words   int
+	Count   int
+	InTitle bool
+	InH1    bool
+	Primary bool
+}
+
 type Store interface {
 	ExportLinks(*models.Crawl) <-chan *Link
 	ExportExternalLinks(*models.Crawl) <-chan *Link
@@ -61,6 +86,9 @@ type Store interface {
 	ExportAudios(crawl *models.Crawl) <-chan *Audio
 	ExportVideos(crawl *models.Crawl) <-chan *Video
 	ExportHreflangs(crawl *models.Crawl) <-chan *Hreflang
+	ExportBrokenResources(crawl *models.Crawl) <-chan *BrokenResource
+	ExportStructuredData(crawl *models.Crawl) <-chan *StructuredData
+	ExportKeywords(crawl *models.Crawl) <-chan *Keyword
 }
 
 type Exporter struct {
@@ -271,3 +299,84 @@ func (e *Exporter) ExportHreflangs(f io.Writer, crawl *models.Crawl) {
 
 	w.Flush()
 }
+
+// Export all broken or redirected images, scripts and styles as a CSV file
+func (e *Exporter) ExportBrokenResources(f io.Writer, crawl *models.Crawl) {
+	w := csv.NewWriter(f)
+
+	w.Write([]string{
+		"Origin",
+		"Resource URL",
+		"Status Code",
+	})
+
+	vStream := e.store.ExportBrokenResources(crawl)
+
+	for v := range vStream {
+		w.Write([]string{
+			v.Origin,
+			v.Resource,
+			strconv.Itoa(v.StatusCode),
+		})
+	}
+
+	w.Flush()
+}
+
+// Export the structured data inventory as a CSV file
+func (e *Exporter) ExportStructuredData(f io.Writer, crawl *models.Crawl) {
+	w := csv.NewWriter(f)
+
+	w.Write([]string{
+		"Origin",
+		"Format",
+		"Type",
+		"Properties",
+		"Valid",
+	})
+
+	vStream := e.store.ExportStructuredData(crawl)
+
+	for v := range vStream {
+		w.Write([]string{
+			v.Origin,
+			v.Format,
+			v.Type,
+			v.Properties,
+			strconv.FormatBool(v.Valid),
+		})
+	}
+
+	w.Flush()
+}
+
+// Export the top terms of each page as a CSV file
+func (e *Exporter) ExportKeywords(f io.Writer, crawl *models.Crawl) {
+	w := csv.NewWriter(f)
+
+	w.Write([]string{
+		"Origin",
+		"Term",
+		"Words",
+		"Count",
+		"In title",
+		"In H1",
+		"Primary",
+	})
+
+	vStream := e.store.ExportKeywords(crawl)
+
+	for v := range vStream {
+		w.Write([]string{
+			v.Origin,
+			v.Term,
+			strconv.Itoa(v.Words),
+			strconv.Itoa(v.Count),
+			strconv.FormatBool(v.InTitle),
+			strconv.FormatBool(v.InH1),
+			strconv.FormatBool(v.Primary),
+		})
+	}
+
+	w.Flush()
+}
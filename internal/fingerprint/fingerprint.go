@@ -0,0 +1,171 @@
+package fingerprint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// Number of consecutive words used to build each one of the shingles of the SimHash.
+const shingleSize = 3
+
+// Fingerprint contains the exact hash and the SimHash of a text.
+type Fingerprint struct {
+	Hash    string
+	SimHash uint64
+}
+
+// Returns the Fingerprint of a list of words. The exact hash is the sha256 hex string of the
+// words joined by a space, and the SimHash is built from shingles of consecutive words.
+// An empty Fingerprint is returned if the words slice is empty.
+func New(words []string) Fingerprint {
+	if len(words) == 0 {
+		return Fingerprint{}
+	}
+
+	h := sha256.New()
+	h.Write([]byte(strings.Join(words, " ")))
+
+	return Fingerprint{
+		Hash:    hex.EncodeToString(h.Sum(nil)),
+		SimHash: SimHash(words),
+	}
+}
+
+// Returns the 64 bit SimHash of a list of words.
+// Each shingle of consecutive words is hashed with FNV-1a and every bit of the
+// resulting hash adds or subtracts one to the corresponding bit counter.
+func SimHash(words []string) uint64 {
+	var v [64]int
+
+	for _, s := range shingles(words) {
+		h := fnv.New64a()
+		h.Write([]byte(s))
+		sum := h.Sum64()
+
+		for i := 0; i < 64; i++ {
+			if sum&(1<<uint(i)) != 0 {
+				v[i]++
+			} else {
+				v[i]--
+			}
+		}
+	}
+
+	var simhash uint64
+	for i := 0; i < 64; i++ {
+		if v[i] > 0 {
+			simhash |= 1 << uint(i)
+		}
+	}
+
+	return simhash
+}
+
+// Returns the similarity between two SimHashes as a percentage,
+// where 100 means both hashes are identical.
+func Similarity(a, b uint64) int {
+	d := bits.OnesCount64(a ^ b)
+
+	return (64 - d) * 100 / 64
+}
+
+// Cluster groups the fingerprints that are exact duplicates or have a similarity equal or
+// above the threshold percentage. It returns the clusters containing more than one fingerprint
+// as slices of indexes of the fps slice. Empty fingerprints are never clustered.
+func Cluster(fps []Fingerprint, threshold int) [][]int {
+	parent := make([]int, len(fps))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+
+		return parent[i]
+	}
+
+	union := func(a, b int) {
+		ra, rb := find(a), find(b)
+		if ra == rb {
+			return
+		}
+
+		if ra < rb {
+			parent[rb] = ra
+		} else {
+			parent[ra] = rb
+		}
+	}
+
+	// Group exact duplicates first so only one fingerprint of each group
+	// is compared in the near duplicates loop.
+	exact := make(map[string]int)
+	unique := []int{}
+	for i, fp := range fps {
+		if fp.Hash == "" {
+			continue
+		}
+
+		if j, ok := exact[fp.Hash]; ok {
+			union(j, i)
+			continue
+		}
+
+		exact[fp.Hash] = i
+		unique = append(unique, i)
+	}
+
+	for x := 0; x < len(unique); x++ {
+		for y := x + 1; y < len(unique); y++ {
+			i, j := unique[x], unique[y]
+			if Similarity(fps[i].SimHash, fps[j].SimHash) >= threshold {
+				union(i, j)
+			}
+		}
+	}
+
+	groups := make(map[int][]int)
+	for i, fp := range fps {
+		if fp.Hash == "" {
+			continue
+		}
+
+		r := find(i)
+		groups[r] = append(groups[r], i)
+	}
+
+	clusters := [][]int{}
+	for _, g := range groups {
+		if len(g) > 1 {
+			clusters = append(clusters, g)
+		}
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i][0] < clusters[j][0]
+	})
+
+	return clusters
+}
+
+// Returns the shingles of consecutive words. If there are fewer words
+// than the shingle size, the whole text is returned as a single shingle.
+func shingles(words []string) []string {
+	if len(words) < shingleSize {
+		return []string{strings.Join(words, " ")}
+	}
+
+	s := make([]string, 0, len(words)-shingleSize+1)
+	for i := 0; i+shingleSize <= len(words); i++ {
+		s = append(s, strings.Join(words[i:i+shingleSize], " "))
+	}
+
+	return s
+}
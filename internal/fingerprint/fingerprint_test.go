@@ -0,0 +1,74 @@
+package fingerprint_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/fingerprint"
+)
+
+const text = `the quick brown fox jumps over the lazy dog while the farmer watches
+from the old wooden fence near the river and the sun slowly sets behind the hills
+of the quiet little village where nothing much ever happens during the long summer`
+
+func TestNew(t *testing.T) {
+	fp := fingerprint.New(strings.Fields(text))
+	if len(fp.Hash) != 64 {
+		t.Errorf("Hash length %d != 64", len(fp.Hash))
+	}
+
+	if fp.SimHash == 0 {
+		t.Error("SimHash should not be 0")
+	}
+
+	empty := fingerprint.New([]string{})
+	if empty.Hash != "" || empty.SimHash != 0 {
+		t.Errorf("Empty fingerprint expected: %+v", empty)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	a := fingerprint.SimHash(strings.Fields(text))
+	b := fingerprint.SimHash(strings.Fields(strings.Replace(text, "lazy", "sleepy", 1)))
+	c := fingerprint.SimHash(strings.Fields("a completely different text about cooking pasta with tomato sauce and basil"))
+
+	if s := fingerprint.Similarity(a, a); s != 100 {
+		t.Errorf("Similarity of identical hashes %d != 100", s)
+	}
+
+	if fingerprint.Similarity(a, b) <= fingerprint.Similarity(a, c) {
+		t.Errorf("Near duplicate text should be more similar than a different text")
+	}
+}
+
+func TestCluster(t *testing.T) {
+	fps := []fingerprint.Fingerprint{
+		{Hash: "a", SimHash: 0xFFFFFFFFFFFFFFFF},
+		{Hash: "b", SimHash: 0x0000000000000000},
+		{Hash: "a", SimHash: 0xFFFFFFFFFFFFFFFF},
+		{Hash: "c", SimHash: 0xFFFFFFFFFFFFFFFE},
+		{Hash: "d", SimHash: 0x00000000FFFFFFFF},
+		{},
+		{},
+	}
+
+	clusters := fingerprint.Cluster(fps, 90)
+	if len(clusters) != 1 {
+		t.Fatalf("Clusters %d != 1", len(clusters))
+	}
+
+	want := []int{0, 2, 3}
+	if len(clusters[0]) != len(want) {
+		t.Fatalf("Cluster %v != %v", clusters[0], want)
+	}
+
+	for i, v := range want {
+		if clusters[0][i] != v {
+			t.Errorf("Cluster %v != %v", clusters[0], want)
+		}
+	}
+
+	if l := len(fingerprint.Cluster(fps, 101)); l != 1 {
+		t.Errorf("Exact duplicates should always be clustered: %d != 1", l)
+	}
+}
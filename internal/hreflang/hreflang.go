@@ -0,0 +1,59 @@
+package hreflang
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// XDefault is the hreflang value of the fallback page for unmatched languages.
+const XDefault = "x-default"
+
+// ValidCode returns true if the hreflang value is x-default or a language code in ISO 639-1
+// format, optionally followed by a script and an ISO 3166-1 alpha-2 region code.
+// Codes that are well-formed but not in their canonical form are not valid, as it happens
+// with common mistakes like en-UK instead of en-GB, or eng instead of en.
+func ValidCode(code string) bool {
+	if strings.EqualFold(code, XDefault) {
+		return true
+	}
+
+	subtags := strings.Split(code, "-")
+	if len(subtags) > 3 {
+		return false
+	}
+
+	b, err := language.ParseBase(subtags[0])
+	if err != nil || b.String() != strings.ToLower(subtags[0]) {
+		return false
+	}
+
+	subtags = subtags[1:]
+	if len(subtags) > 0 && len(subtags[0]) == 4 {
+		if _, err := language.ParseScript(subtags[0]); err != nil {
+			return false
+		}
+		subtags = subtags[1:]
+	}
+
+	if len(subtags) == 0 {
+		return true
+	}
+
+	if len(subtags) > 1 || len(subtags[0]) != 2 {
+		return false
+	}
+
+	r, err := language.ParseRegion(subtags[0])
+	if err != nil || !r.IsCountry() || r.Canonicalize() != r || r.ISO3() == "ZZZ" {
+		return false
+	}
+
+	return true
+}
+
+// PrimaryLanguage returns the lowercased primary language subtag of a language code.
+// ex. "en-GB" returns "en".
+func PrimaryLanguage(code string) string {
+	return strings.ToLower(strings.Split(strings.Replace(code, "_", "-", -1), "-")[0])
+}
@@ -0,0 +1,156 @@
+package hreflang
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// ClustersLimit is the maximum number of clusters returned by GetClusters.
+const ClustersLimit = 100
+
+type HreflangStore interface {
+	FindHreflangAnnotations(crawlId int64) []models.HreflangAnnotation
+}
+
+type Service struct {
+	store HreflangStore
+}
+
+// Cluster is a group of pages connected by hreflang annotations. It is displayed as
+// a matrix with a row for each page and a column for each hreflang language.
+type Cluster struct {
+	Languages []string
+	Rows      []Row
+}
+
+// Row is a page in a Cluster with the hreflang annotations it declares, one cell for
+// each of the cluster languages. PageReportId is 0 for pages that are only the target
+// of hreflang annotations and don't declare any.
+type Row struct {
+	PageReportId int64
+	URL          string
+	Lang         string
+	Cells        []Cell
+}
+
+// Cell is the URL a page declares for one of the cluster languages. Self is true if the
+// URL is the page itself and Return is true if the URL's page links back to the page.
+type Cell struct {
+	URL    string
+	Self   bool
+	Return bool
+}
+
+func NewService(s HreflangStore) *Service {
+	return &Service{
+		store: s,
+	}
+}
+
+// GetClusters returns the hreflang clusters of a crawl sorted by number of pages,
+// the largest cluster first. It returns up to ClustersLimit clusters.
+func (s *Service) GetClusters(crawlId int64) []Cluster {
+	annotations := s.store.FindHreflangAnnotations(crawlId)
+
+	parent := make(map[string]string)
+	var find func(u string) string
+	find = func(u string) string {
+		if _, ok := parent[u]; !ok {
+			parent[u] = u
+		}
+		if parent[u] != u {
+			parent[u] = find(parent[u])
+		}
+		return parent[u]
+	}
+
+	rows := make(map[string]*Row)
+	links := make(map[string]map[string]string) // page URL to language to target URL
+	order := []string{}
+	addRow := func(u string) *Row {
+		r, ok := rows[u]
+		if !ok {
+			r = &Row{URL: u}
+			rows[u] = r
+			links[u] = make(map[string]string)
+			order = append(order, u)
+		}
+		return r
+	}
+
+	for _, a := range annotations {
+		r := addRow(a.URL)
+		r.PageReportId = a.PageReportId
+		r.Lang = a.Lang
+		addRow(a.ToURL)
+
+		lang := strings.ToLower(a.ToLang)
+		if _, ok := links[a.URL][lang]; !ok {
+			links[a.URL][lang] = a.ToURL
+		}
+
+		parent[find(a.URL)] = find(a.ToURL)
+	}
+
+	groups := make(map[string][]string)
+	roots := []string{}
+	for _, u := range order {
+		root := find(u)
+		if _, ok := groups[root]; !ok {
+			roots = append(roots, root)
+		}
+		groups[root] = append(groups[root], u)
+	}
+
+	clusters := []Cluster{}
+	for _, root := range roots {
+		urls := groups[root]
+		langSet := make(map[string]bool)
+		for _, u := range urls {
+			for l := range links[u] {
+				langSet[l] = true
+			}
+		}
+
+		c := Cluster{}
+		for l := range langSet {
+			c.Languages = append(c.Languages, l)
+		}
+		sort.Slice(c.Languages, func(i, j int) bool {
+			if c.Languages[i] == XDefault || c.Languages[j] == XDefault {
+				return c.Languages[j] == XDefault && c.Languages[i] != XDefault
+			}
+			return c.Languages[i] < c.Languages[j]
+		})
+
+		sort.Strings(urls)
+		for _, u := range urls {
+			r := *rows[u]
+			for _, l := range c.Languages {
+				to := links[u][l]
+				cell := Cell{URL: to, Self: to == u}
+				for _, back := range links[to] {
+					if back == u {
+						cell.Return = true
+					}
+				}
+				r.Cells = append(r.Cells, cell)
+			}
+			c.Rows = append(c.Rows, r)
+		}
+
+		clusters = append(clusters, c)
+	}
+
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return len(clusters[i].Rows) > len(clusters[j].Rows)
+	})
+
+	if len(clusters) > ClustersLimit {
+		clusters = clusters[:ClustersLimit]
+	}
+
+	return clusters
+}
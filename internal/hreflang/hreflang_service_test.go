@@ -0,0 +1,68 @@
+package hreflang_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/hreflang"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type storage struct {
+	annotations []models.HreflangAnnotation
+}
+
+func (s *storage) FindHreflangAnnotations(crawlId int64) []models.HreflangAnnotation {
+	return s.annotations
+}
+
+func TestGetClusters(t *testing.T) {
+	en := "https://example.com/en/"
+	es := "https://example.com/es/"
+	fr := "https://example.com/fr/"
+	other := "https://example.com/other/"
+
+	store := &storage{annotations: []models.HreflangAnnotation{
+		{PageReportId: 1, URL: en, Lang: "en", ToURL: en, ToLang: "en"},
+		{PageReportId: 1, URL: en, Lang: "en", ToURL: es, ToLang: "es"},
+		{PageReportId: 1, URL: en, Lang: "en", ToURL: en, ToLang: "x-default"},
+		{PageReportId: 2, URL: es, Lang: "es", ToURL: en, ToLang: "en"},
+		{PageReportId: 2, URL: es, Lang: "es", ToURL: fr, ToLang: "fr"},
+		{PageReportId: 3, URL: other, Lang: "en", ToURL: other, ToLang: "en"},
+	}}
+
+	clusters := hreflang.NewService(store).GetClusters(1)
+	if len(clusters) != 2 {
+		t.Fatalf("clusters: %d != 2", len(clusters))
+	}
+
+	c := clusters[0]
+	wantLangs := []string{"en", "es", "fr", "x-default"}
+	if len(c.Languages) != len(wantLangs) {
+		t.Fatalf("languages: %v != %v", c.Languages, wantLangs)
+	}
+	for i, l := range wantLangs {
+		if c.Languages[i] != l {
+			t.Errorf("language %d: %s != %s", i, c.Languages[i], l)
+		}
+	}
+
+	if len(c.Rows) != 3 {
+		t.Fatalf("rows: %d != 3", len(c.Rows))
+	}
+
+	// Rows are sorted by URL: en, es, fr.
+	enRow := c.Rows[0]
+	if enRow.PageReportId != 1 || !enRow.Cells[0].Self || enRow.Cells[1].URL != es || !enRow.Cells[1].Return {
+		t.Errorf("en row: %+v", enRow)
+	}
+
+	esRow := c.Rows[1]
+	if esRow.Cells[2].URL != fr || esRow.Cells[2].Return {
+		t.Errorf("es row: %+v", esRow)
+	}
+
+	frRow := c.Rows[2]
+	if frRow.PageReportId != 0 || frRow.Cells[0].URL != "" {
+		t.Errorf("fr row: %+v", frRow)
+	}
+}
@@ -0,0 +1,52 @@
+package hreflang_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/hreflang"
+)
+
+func TestValidCode(t *testing.T) {
+	table := []struct {
+		code string
+		want bool
+	}{
+		{"en", true},
+		{"en-GB", true},
+		{"en-gb", true},
+		{"zh-Hant-TW", true},
+		{"x-default", true},
+		{"X-Default", true},
+		{"en-UK", false},
+		{"eng", false},
+		{"gb", false},
+		{"en-EU", false},
+		{"es-419", false},
+		{"en_US", false},
+		{"en-US-x", false},
+		{"", false},
+	}
+
+	for _, v := range table {
+		if got := hreflang.ValidCode(v.code); got != v.want {
+			t.Errorf("ValidCode(%q): %v != %v", v.code, got, v.want)
+		}
+	}
+}
+
+func TestPrimaryLanguage(t *testing.T) {
+	table := []struct {
+		code string
+		want string
+	}{
+		{"en-GB", "en"},
+		{"ES", "es"},
+		{"pt_BR", "pt"},
+	}
+
+	for _, v := range table {
+		if got := hreflang.PrimaryLanguage(v.code); got != v.want {
+			t.Errorf("PrimaryLanguage(%q): %s != %s", v.code, got, v.want)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package html_parser
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Input types that don't need a label, either because they are not visible or
+// because they are buttons.
+var unlabeledInputTypes = map[string]bool{
+	"hidden": true,
+	"submit": true,
+	"reset":  true,
+	"button": true,
+	"image":  true,
+}
+
+// Elements whose text is not part of the accessible name.
+var nonNameElements = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"template": true,
+}
+
+// Image roles that mark the image as decorative.
+var decorativeRoles = map[string]bool{
+	"presentation": true,
+	"none":         true,
+}
+
+// Returns the number of elements that fail the basic accessibility checks: form fields
+// without labels, buttons and links without accessible names, elements with a positive
+// tabindex, iframes without title and images without text alternative. It also checks
+// the html element declares the page language.
+func (p *Parser) htmlAccessibility() models.Accessibility {
+	a := models.Accessibility{
+		MissingLang: strings.TrimSpace(p.htmlLang()) == "",
+	}
+
+	labels := map[string]bool{}
+	for _, n := range htmlquery.Find(p.doc, "//label[@for]") {
+		labels[strings.TrimSpace(htmlquery.SelectAttr(n, "for"))] = true
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//input | //select | //textarea") {
+		if n.Data == "input" && unlabeledInputTypes[inputType(n)] {
+			continue
+		}
+
+		if isAriaHidden(n) || hasAriaName(n) {
+			continue
+		}
+
+		id := strings.TrimSpace(htmlquery.SelectAttr(n, "id"))
+		if (id != "" && labels[id]) || hasAncestor(n, "label") {
+			continue
+		}
+
+		a.InputsWithoutLabel++
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//button | //input | //*[@role=\"button\"]") {
+		if isAriaHidden(n) {
+			continue
+		}
+
+		if n.Data == "input" {
+			switch inputType(n) {
+			case "button":
+				if strings.TrimSpace(htmlquery.SelectAttr(n, "value")) == "" && !hasAriaName(n) {
+					a.ButtonsWithoutName++
+				}
+			case "image":
+				if strings.TrimSpace(htmlquery.SelectAttr(n, "alt")) == "" && !hasAriaName(n) {
+					a.ButtonsWithoutName++
+				}
+			}
+
+			continue
+		}
+
+		if !p.hasAccessibleName(n) {
+			a.ButtonsWithoutName++
+		}
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//a[@href]") {
+		if !isAriaHidden(n) && !p.hasAccessibleName(n) {
+			a.LinksWithoutName++
+		}
+	}
+
+	// An empty alt marks the image as decorative, so only the missing alt attributes are counted.
+	for _, n := range htmlquery.Find(p.doc, "//img[not(@alt)]") {
+		role := strings.ToLower(strings.TrimSpace(htmlquery.SelectAttr(n, "role")))
+		if isAriaHidden(n) || decorativeRoles[role] || p.attributesName(n) != "" {
+			continue
+		}
+
+		a.ImagesWithoutAlt++
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//*[@tabindex]") {
+		t, err := strconv.Atoi(strings.TrimSpace(htmlquery.SelectAttr(n, "tabindex")))
+		if err == nil && t > 0 {
+			a.PositiveTabindex++
+		}
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//iframe") {
+		if !isAriaHidden(n) && !hasAriaName(n) {
+			a.IframesWithoutTitle++
+		}
+	}
+
+	return a
+}
+
+// Returns the lowercased type attribute of an input element, which defaults to "text".
+func inputType(n *html.Node) string {
+	t := strings.ToLower(strings.TrimSpace(htmlquery.SelectAttr(n, "type")))
+	if t == "" {
+		return "text"
+	}
+
+	return t
+}
+
+// Returns true if the element is hidden from assistive technologies.
+func isAriaHidden(n *html.Node) bool {
+	return strings.TrimSpace(htmlquery.SelectAttr(n, "aria-hidden")) == "true"
+}
+
+// Returns true if the element is named with the aria-label, aria-labelledby or title attributes.
+func hasAriaName(n *html.Node) bool {
+	for _, attr := range []string{"aria-label", "aria-labelledby", "title"} {
+		if strings.TrimSpace(htmlquery.SelectAttr(n, attr)) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the name given to the element by its aria-label, aria-labelledby or title attributes,
+// in this order of precedence. The aria-labelledby name is the text of the referenced elements.
+func (p *Parser) attributesName(n *html.Node) string {
+	if l := strings.TrimSpace(htmlquery.SelectAttr(n, "aria-label")); l != "" {
+		return l
+	}
+
+	names := []string{}
+	for _, id := range strings.Fields(htmlquery.SelectAttr(n, "aria-labelledby")) {
+		e := htmlquery.FindOne(p.doc, "//*[@id="+strconv.Quote(id)+"]")
+		if e == nil {
+			continue
+		}
+
+		if t := strings.Join(strings.Fields(nodeText(e, nonNameElements)), " "); t != "" {
+			names = append(names, t)
+		}
+	}
+
+	if len(names) > 0 {
+		return strings.Join(names, " ")
+	}
+
+	return strings.TrimSpace(htmlquery.SelectAttr(n, "title"))
+}
+
+// Returns true if the element has an accessible name, either in its attributes, in its text
+// or in the alternative text of its images.
+func (p *Parser) hasAccessibleName(n *html.Node) bool {
+	if p.attributesName(n) != "" {
+		return true
+	}
+
+	if strings.TrimSpace(nodeText(n, nonNameElements)) != "" {
+		return true
+	}
+
+	for _, c := range htmlquery.Find(n, ".//img[@alt] | .//area[@alt] | .//svg[@aria-label]") {
+		if strings.TrimSpace(htmlquery.SelectAttr(c, "alt")+htmlquery.SelectAttr(c, "aria-label")) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns true if the node has an ancestor element with the given name.
+func hasAncestor(n *html.Node, name string) bool {
+	for a := n.Parent; a != nil; a = a.Parent {
+		if a.Type == html.ElementNode && a.Data == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,71 @@
+package html_parser
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// Returns the HTTP caching headers of the response along with its freshness lifetime.
+func (p *Parser) headersCache() models.CacheHeaders {
+	h := models.CacheHeaders{
+		CacheControl: strings.Join(p.Headers.Values("Cache-Control"), ", "),
+		Expires:      p.Headers.Get("Expires"),
+		Age:          p.Headers.Get("Age"),
+		ETag:         p.Headers.Get("ETag"),
+		LastModified: p.Headers.Get("Last-Modified"),
+		SetCookie:    len(p.Headers.Values("Set-Cookie")) > 0,
+	}
+
+	h.MaxAge = p.cacheMaxAge(h.CacheControl, h.Expires)
+
+	return h
+}
+
+// Returns the freshness lifetime in seconds of the response. The max-age directive of the
+// Cache-Control header takes precedence over the Expires header, which is relative to the
+// Date header. The no-store and no-cache directives and invalid Expires dates return 0.
+// It returns -1 if the lifetime is not specified.
+func (p *Parser) cacheMaxAge(cacheControl, expires string) int64 {
+	maxAge := int64(-1)
+	for _, d := range strings.Split(strings.ToLower(cacheControl), ",") {
+		kv := strings.SplitN(strings.TrimSpace(d), "=", 2)
+		switch kv[0] {
+		case "no-store", "no-cache":
+			return 0
+		case "max-age":
+			if len(kv) != 2 {
+				continue
+			}
+
+			v, err := strconv.ParseInt(strings.Trim(kv[1], `"`), 10, 64)
+			if err != nil || v < 0 {
+				v = 0
+			}
+			maxAge = v
+		}
+	}
+
+	if maxAge >= 0 || expires == "" {
+		return maxAge
+	}
+
+	e, err := http.ParseTime(expires)
+	if err != nil {
+		return 0
+	}
+
+	date, err := http.ParseTime(p.Headers.Get("Date"))
+	if err != nil {
+		date = time.Now()
+	}
+
+	if e.Before(date) {
+		return 0
+	}
+
+	return int64(e.Sub(date).Seconds())
+}
@@ -0,0 +1,117 @@
+package html_parser
+
+import (
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/fingerprint"
+	"github.com/stjudewashere/seonaut/internal/keywords"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+)
+
+// Elements that are not considered part of the main content of the page.
+var nonContentElements = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"noscript": true,
+	"template": true,
+	"svg":      true,
+	"nav":      true,
+	"header":   true,
+	"footer":   true,
+	"aside":    true,
+}
+
+// Block elements that separate the text in different blocks.
+var blockElements = map[string]bool{
+	"p":          true,
+	"div":        true,
+	"li":         true,
+	"td":         true,
+	"th":         true,
+	"br":         true,
+	"h1":         true,
+	"h2":         true,
+	"h3":         true,
+	"h4":         true,
+	"h5":         true,
+	"h6":         true,
+	"blockquote": true,
+	"section":    true,
+	"article":    true,
+}
+
+// Returns the fingerprint of the page's main content. The main content is the text
+// in the main element, or in the body if there is no main element, leaving out the
+// text of navigation, header, footer and aside elements, as well as scripts and styles.
+func (p *Parser) contentFingerprint() fingerprint.Fingerprint {
+	n := p.mainContentNode()
+	if n == nil {
+		return fingerprint.Fingerprint{}
+	}
+
+	return fingerprint.New(contentWords(n))
+}
+
+// Returns the term counts of the page's main content, using the stopwords
+// of the page language.
+func (p *Parser) terms(lang string) map[string]int {
+	n := p.mainContentNode()
+	if n == nil {
+		return nil
+	}
+
+	return keywords.Count(contentWords(n), lang)
+}
+
+// Returns the main element node, or the body node if there is no main element.
+func (p *Parser) mainContentNode() *html.Node {
+	n, err := htmlquery.Query(p.doc, "//main")
+	if err != nil || n == nil {
+		return p.htmlBodyNode()
+	}
+
+	return n
+}
+
+// Returns the lowercased words in the text of the node, skipping the
+// non content elements. Punctuation and symbols are removed.
+func contentWords(n *html.Node) []string {
+	return keywords.Words(nodeText(n, nonContentElements))
+}
+
+// Returns the text of the node skipping the elements in the skip map.
+// A line break is added after each block element, so the text of different
+// blocks, such as headings or list items, is not joined.
+func nodeText(n *html.Node, skip map[string]bool) string {
+	var buf strings.Builder
+
+	var output func(n *html.Node)
+	output = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			buf.WriteString(n.Data)
+			buf.WriteString(" ")
+			return
+		case html.CommentNode:
+			return
+		case html.ElementNode:
+			if skip[n.Data] {
+				return
+			}
+		}
+
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			output(child)
+		}
+
+		if n.Type == html.ElementNode && blockElements[n.Data] {
+			buf.WriteString("\n")
+		}
+	}
+
+	output(n)
+
+	return buf.String()
+}
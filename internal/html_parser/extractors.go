@@ -0,0 +1,189 @@
+package html_parser
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/antchfx/htmlquery"
+	"github.com/antchfx/xpath"
+)
+
+const (
+	// Max number of matches of each extractor that are kept.
+	maxExtractionMatches = 10
+
+	// Max length of the extracted value.
+	maxExtractionLength = 2048
+
+	// Separator used to join the extractor matches in a single value.
+	extractionSeparator = " | "
+)
+
+// Matches the ::attr(name) pseudo-element at the end of a CSS selector, which is used
+// to extract the value of an attribute instead of the text of the matching elements.
+var cssAttrRegex = regexp.MustCompile(`^(.*?)\s*::attr\(\s*([^\s()]+)\s*\)\s*$`)
+
+// Extractor is a custom extractor with its expression compiled, so the expression is compiled
+// once per crawl instead of once per parsed page.
+type Extractor struct {
+	Name    string
+	xpath   *xpath.Expr
+	css     cascadia.SelectorGroup
+	cssAttr string // Attribute extracted by the CSS selector, empty to extract the text
+	regex   *regexp.Regexp
+}
+
+// ValidateExtractor returns an error if the extractor has no name, its type is not supported
+// or its expression can't be compiled.
+func ValidateExtractor(e models.Extractor) error {
+	if strings.TrimSpace(e.Name) == "" {
+		return errors.New("extractor name is empty")
+	}
+
+	if strings.TrimSpace(e.Expression) == "" {
+		return errors.New("extractor expression is empty")
+	}
+
+	_, err := compileExtractor(e)
+
+	return err
+}
+
+// CompileExtractors returns the extractors with their expressions compiled.
+// Extractors with expressions that can't be compiled are not included.
+func CompileExtractors(extractors []models.Extractor) []*Extractor {
+	compiled := []*Extractor{}
+	for _, e := range extractors {
+		c, err := compileExtractor(e)
+		if err != nil {
+			continue
+		}
+
+		compiled = append(compiled, c)
+	}
+
+	return compiled
+}
+
+// Returns an Extractor with the compiled XPath expression, CSS selector or regular expression.
+func compileExtractor(e models.Extractor) (*Extractor, error) {
+	c := &Extractor{Name: e.Name}
+	switch e.Type {
+	case models.ExtractorXPath:
+		x, err := xpath.Compile(e.Expression)
+		if err != nil {
+			return nil, err
+		}
+		c.xpath = x
+	case models.ExtractorCSS:
+		selector := e.Expression
+		if m := cssAttrRegex.FindStringSubmatch(selector); m != nil {
+			selector, c.cssAttr = m[1], m[2]
+		}
+		sel, err := cascadia.ParseGroup(selector)
+		if err != nil {
+			return nil, err
+		}
+		c.css = sel
+	case models.ExtractorRegex:
+		re, err := regexp.Compile(e.Expression)
+		if err != nil {
+			return nil, err
+		}
+		c.regex = re
+	default:
+		return nil, errors.New("extractor type not supported")
+	}
+
+	return c, nil
+}
+
+// Returns the values found by each one of the extractors. XPath and CSS selector extractors
+// return the text of the matched nodes, and regex extractors return the first submatch, or the
+// whole match if the expression has no groups. Extractors without matches are not included.
+func (p *Parser) extract(extractors []*Extractor) []models.Extraction {
+	extractions := []models.Extraction{}
+	for _, e := range extractors {
+		var values []string
+		if e.xpath != nil {
+			values = p.extractXPath(e.xpath)
+		} else if e.css != nil {
+			values = p.extractCSS(e.css, e.cssAttr)
+		} else if e.regex != nil {
+			values = p.extractRegex(e.regex)
+		}
+
+		if len(values) == 0 {
+			continue
+		}
+
+		value := truncate(strings.Join(values, extractionSeparator), maxExtractionLength)
+		extractions = append(extractions, models.Extraction{Name: e.Name, Value: value})
+	}
+
+	return extractions
+}
+
+// Returns the trimmed text of the nodes matching the XPath expression.
+func (p *Parser) extractXPath(expr *xpath.Expr) []string {
+	nodes := htmlquery.QuerySelectorAll(p.doc, expr)
+	values := []string{}
+	for _, n := range nodes {
+		v := strings.Join(strings.Fields(htmlquery.InnerText(n)), " ")
+		if v == "" {
+			continue
+		}
+
+		values = append(values, v)
+		if len(values) == maxExtractionMatches {
+			break
+		}
+	}
+
+	return values
+}
+
+// Returns the trimmed text of the nodes matching the CSS selector, or the value of
+// their attribute if attr is not empty.
+func (p *Parser) extractCSS(sel cascadia.SelectorGroup, attr string) []string {
+	values := []string{}
+	for _, n := range cascadia.QueryAll(p.doc, sel) {
+		v := htmlquery.InnerText(n)
+		if attr != "" {
+			v = htmlquery.SelectAttr(n, attr)
+		}
+
+		v = strings.Join(strings.Fields(v), " ")
+		if v == "" {
+			continue
+		}
+
+		values = append(values, v)
+		if len(values) == maxExtractionMatches {
+			break
+		}
+	}
+
+	return values
+}
+
+// Returns the matches of the regular expression in the raw HTML.
+func (p *Parser) extractRegex(re *regexp.Regexp) []string {
+	values := []string{}
+	for _, m := range re.FindAllSubmatch(p.body, maxExtractionMatches) {
+		v := m[0]
+		if len(m) > 1 {
+			v = m[1]
+		}
+
+		if s := strings.TrimSpace(string(v)); s != "" {
+			values = append(values, s)
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,210 @@
+package html_parser
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Elements that are allowed in the head. Any other element closes the head early.
+var headElements = map[string]bool{
+	"html":     true,
+	"head":     true,
+	"base":     true,
+	"basefont": true,
+	"bgsound":  true,
+	"link":     true,
+	"meta":     true,
+	"noframes": true,
+	"noscript": true,
+	"script":   true,
+	"style":    true,
+	"template": true,
+	"title":    true,
+}
+
+// Elements in the head whose content is raw text and not part of the document tree.
+var rawTextElements = map[string]bool{
+	"noframes": true,
+	"noscript": true,
+	"script":   true,
+	"style":    true,
+	"title":    true,
+}
+
+// Returns the head elements of the document.
+// The parsed document is repaired by the HTML parser, so the head is checked
+// tokenizing the original response body.
+func (p *Parser) htmlHeadElements() models.HeadElements {
+	h := models.HeadElements{
+		DuplicateIds: p.duplicateIds(),
+		Titles:       p.countNodes("//title[not(ancestor::svg)]"),
+		Canonicals:   p.countNodes("//link[@rel=\"canonical\"]"),
+	}
+
+	h.BreakingElement, h.TagsAfterBreak = p.headBreak()
+
+	return h
+}
+
+// Returns the first element that closes an explicit head before its end tag, along with
+// the SEO tags that come after it until the end of the original head. Those tags are
+// placed in the body by browsers and search engines, which will ignore them.
+// ex. <head><img src="pixel.gif"><link rel="canonical" href="/"></head>
+func (p *Parser) headBreak() (string, []string) {
+	z := html.NewTokenizer(bytes.NewReader(p.body))
+
+	breaking := ""
+	tags := []string{}
+	head := false
+	raw := false
+	templates := 0
+
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return breaking, tags
+		case html.TextToken:
+			if head && breaking == "" && !raw && templates == 0 && strings.TrimSpace(string(z.Text())) != "" {
+				breaking = "#text"
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			tag := string(name)
+			attrs := tokenAttributes(z, hasAttr)
+
+			if tag == "body" {
+				return breaking, tags
+			}
+
+			if templates > 0 {
+				if tag == "template" && tt == html.StartTagToken {
+					templates++
+				}
+				continue
+			}
+
+			if breaking == "" {
+				if !head {
+					head = tag == "head"
+				} else if !headElements[tag] {
+					breaking = tag
+				} else if tt == html.StartTagToken {
+					raw = rawTextElements[tag]
+					if tag == "template" {
+						templates++
+					}
+				}
+
+				continue
+			}
+
+			if t := seoTag(tag, attrs); t != "" && !contains(tags, t) {
+				tags = append(tags, t)
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+
+			if tag == "head" || tag == "body" || tag == "html" {
+				return breaking, tags
+			}
+
+			if tag == "template" && templates > 0 {
+				templates--
+			}
+
+			if rawTextElements[tag] {
+				raw = false
+			}
+		}
+	}
+}
+
+// Returns the name of the SEO tag the element defines, or an empty string if it is not one.
+func seoTag(tag string, attrs map[string]string) string {
+	switch tag {
+	case "title":
+		return "title"
+	case "meta":
+		switch strings.ToLower(attrs["name"]) {
+		case "description":
+			return "description"
+		case "robots", "googlebot":
+			return "robots"
+		}
+	case "link":
+		rels := strings.Fields(strings.ToLower(attrs["rel"]))
+		if contains(rels, "canonical") {
+			return "canonical"
+		}
+
+		if contains(rels, "alternate") && attrs["hreflang"] != "" {
+			return "hreflang"
+		}
+	}
+
+	return ""
+}
+
+// Returns the attributes of the current token in the tokenizer.
+func tokenAttributes(z *html.Tokenizer, hasAttr bool) map[string]string {
+	attrs := map[string]string{}
+	for hasAttr {
+		var k, v []byte
+		k, v, hasAttr = z.TagAttr()
+		attrs[string(k)] = strings.TrimSpace(string(v))
+	}
+
+	return attrs
+}
+
+// Returns the id attribute values that are used by more than one element.
+func (p *Parser) duplicateIds() []string {
+	nodes, err := htmlquery.QueryAll(p.doc, "//*[@id]")
+	if err != nil {
+		return nil
+	}
+
+	count := map[string]int{}
+	duplicates := []string{}
+	for _, n := range nodes {
+		id := strings.TrimSpace(htmlquery.SelectAttr(n, "id"))
+		if id == "" {
+			continue
+		}
+
+		count[id]++
+		if count[id] == 2 {
+			duplicates = append(duplicates, id)
+		}
+	}
+
+	return duplicates
+}
+
+// Returns the number of nodes matching the xpath expression.
+func (p *Parser) countNodes(expr string) int {
+	nodes, err := htmlquery.QueryAll(p.doc, expr)
+	if err != nil {
+		return 0
+	}
+
+	return len(nodes)
+}
+
+// Returns true if the slice contains the string.
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
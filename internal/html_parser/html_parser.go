@@ -9,9 +9,9 @@ import (
 	"mime"
 	"net/http"
 	"net/url"
-	"regexp"
 	"strings"
 
+	"github.com/stjudewashere/seonaut/internal/keywords"
 	"github.com/stjudewashere/seonaut/internal/models"
 
 	"golang.org/x/net/html"
@@ -22,10 +22,20 @@ const (
 	// MaxBodySize is the limit of the retrieved response body in bytes.
 	// The default value for MaxBodySize is 10MB (10 * 1024 * 1024 bytes).
 	maxBodySize = 10 * 1024 * 1024
+
+	// Max length of the image width and height attributes stored in the database.
+	maxImageDimensionLength = 16
 )
 
+// Options contains the project specific rules that are evaluated when parsing a page.
+type Options struct {
+	Extractors  []*Extractor
+	SearchRules []*SearchRule
+	Bot         string // Bot name used to evaluate the bot specific robots directives
+}
+
 // Create a new PageReport from an http.Response.
-func NewFromHTTPResponse(r *http.Response) (*models.PageReport, error) {
+func NewFromHTTPResponse(r *http.Response, o *Options) (*models.PageReport, error) {
 	defer r.Body.Close()
 
 	var bodyReader io.Reader = r.Body
@@ -36,11 +46,16 @@ func NewFromHTTPResponse(r *http.Response) (*models.PageReport, error) {
 		return &models.PageReport{}, err
 	}
 
-	return New(r.Request.URL, r.StatusCode, &r.Header, b)
+	return NewWithOptions(r.Request.URL, r.StatusCode, &r.Header, b, o)
 }
 
 // Return a new PageReport.
 func New(u *url.URL, status int, headers *http.Header, body []byte) (*models.PageReport, error) {
+	return NewWithOptions(u, status, headers, body, &Options{})
+}
+
+// Return a new PageReport evaluating the project specific rules in the options.
+func NewWithOptions(u *url.URL, status int, headers *http.Header, body []byte, o *Options) (*models.PageReport, error) {
 	parser, err := newParser(u, headers, body)
 	if err != nil {
 		return nil, err
@@ -60,12 +75,16 @@ func New(u *url.URL, status int, headers *http.Header, body []byte) (*models.Pag
 		log.Printf("NewPageReport URL: %s\n Error: %v", u.String(), err)
 	}
 
+	pageReport.CacheHeaders = parser.headersCache()
+
 	if pageReport.StatusCode >= http.StatusMultipleChoices && pageReport.StatusCode < http.StatusBadRequest {
 		pageReport.RedirectURL = parser.headersLocation()
 
 		return &pageReport, nil
 	}
 
+	pageReport.RobotsDirectives = parser.headersRobotsDirectives()
+
 	if isHTML(&pageReport) {
 		pageReport.Lang = parser.lang()
 		pageReport.ValidLang = langIsValid(pageReport.Lang)
@@ -73,19 +92,25 @@ func New(u *url.URL, status int, headers *http.Header, body []byte) (*models.Pag
 		pageReport.Description = parser.htmlMetaDescription()
 		pageReport.Refresh = parser.htmlMetaRefresh()
 		pageReport.RedirectURL = parser.htmlMetaRefreshURL()
-		pageReport.Robots = parser.robots()
-		pageReport.Noindex = strings.Contains(pageReport.Robots, "noindex")
-		pageReport.Nofollow = strings.Contains(pageReport.Robots, "nofollow")
+		pageReport.RobotsDirectives = parser.robotsDirectives()
 		pageReport.H1 = parser.htmlH1()
 		pageReport.H2 = parser.htmlH2()
 		pageReport.Canonical = parser.canonical()
+		pageReport.Canonicals = parser.canonicals()
 		pageReport.Hreflangs = parser.hreflangs()
+		pageReport.LinkRelations = parser.htmlLinkRelations()
 		pageReport.Images = parser.htmlImages()
 		pageReport.Iframes = parser.htmlIframes()
 		pageReport.Audios = parser.htmlAudios()
 		pageReport.Videos = parser.htmlVideos()
 		pageReport.Scripts = parser.htmlScripts()
 		pageReport.Styles = parser.htmlStyles()
+		pageReport.StructuredData = parser.structuredData()
+		pageReport.SocialTags = parser.htmlSocialTags()
+		pageReport.PageSetup = parser.htmlPageSetup()
+		pageReport.HeadElements = parser.htmlHeadElements()
+		pageReport.Accessibility = parser.htmlAccessibility()
+		pageReport.SecurityHeaders = parser.headersSecurity()
 
 		pictures := parser.htmlPictures()
 		pageReport.Images = append(pageReport.Images, pictures...)
@@ -102,10 +127,26 @@ func New(u *url.URL, status int, headers *http.Header, body []byte) (*models.Pag
 		bnode := parser.htmlBodyNode()
 		if bnode != nil {
 			pageReport.Words = countWords(bnode)
-			pageReport.ValidHeadings = headingOrderIsValid(bnode)
+			pageReport.Headings = parser.htmlHeadings(bnode)
+			pageReport.ValidHeadings = headingsAreValid(pageReport.Headings)
 		}
+
+		fp := parser.contentFingerprint()
+		pageReport.ContentHash = fp.Hash
+		pageReport.SimHash = fp.SimHash
+		pageReport.Terms = parser.terms(pageReport.Lang)
+		pageReport.Keywords = keywords.Extract(pageReport.Terms, pageReport.Title, pageReport.H1)
+
+		parser.readability(&pageReport)
+
+		pageReport.Extractions = parser.extract(o.Extractors)
+		pageReport.CustomIssues = parser.search(o.SearchRules)
+	} else if pageReport.MediaType == PDFMediaType {
+		parser.pdf(&pageReport)
 	}
 
+	EvaluateRobots(&pageReport, o.Bot)
+
 	return &pageReport, nil
 }
 
@@ -144,57 +185,29 @@ func countWords(n *html.Node) int {
 	var buf bytes.Buffer
 	output(&buf, n)
 
-	re, err := regexp.Compile(`[\p{P}\p{S}]+`)
-	if err != nil {
-		log.Printf("countWords: %v\n", err)
-	}
-	t := re.ReplaceAllString(buf.String(), " ")
-
-	return len(strings.Fields(t))
+	return len(keywords.Words(buf.String()))
 }
 
-// Check if the H headings order is valid.
-func headingOrderIsValid(n *html.Node) bool {
-	headings := [6]string{"h1", "h2", "h3", "h4", "h5", "h6"}
-	current := 0
-
-	isValidHeading := func(el string) (int, bool) {
-		el = strings.ToLower(el)
-		for i, v := range headings {
-			if v == el {
-				return i, true
-			}
-		}
-
-		return 0, false
+// Returns the string truncated to a maximum number of runes, so multi-byte
+// characters are never split.
+func truncate(s string, max int) string {
+	r := []rune(s)
+	if len(r) <= max {
+		return s
 	}
 
-	var output func(n *html.Node) bool
-	output = func(n *html.Node) bool {
-		if n.Type == html.ElementNode {
-			p, ok := isValidHeading(n.Data)
-			if ok {
-				if p > current+1 {
-					return false
-				}
-				current = p
-			}
-		}
+	return string(r[:max])
+}
 
-		for child := n.FirstChild; child != nil; child = child.NextSibling {
-			if child.Type == html.ElementNode {
-				if output(child) == false {
-					return false
-				}
-			}
+// Returns false if any of the headings skips one or more levels.
+func headingsAreValid(headings []models.Heading) bool {
+	for _, h := range headings {
+		if h.SkipsLevel {
+			return false
 		}
-
-		return true
 	}
 
-	correct := output(n)
-
-	return correct
+	return true
 }
 
 // Check if a language code provided by the Content-Language header or HTML lang attribute is valid.
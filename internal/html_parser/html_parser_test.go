@@ -1,14 +1,18 @@
 package html_parser_test
 
 import (
+	"bytes"
+	"compress/zlib"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stjudewashere/seonaut/internal/html_parser"
+	"github.com/stjudewashere/seonaut/internal/models"
 )
 
 const (
@@ -132,7 +136,8 @@ func TestPageReportHTML(t *testing.T) {
 		{want: "https://example.com/test-page/link2", got: pageReport.Links[1].URL},
 		{want: "link1", got: pageReport.Links[0].Text},
 		{want: "nofollow", got: pageReport.Links[0].Rel},
-		{want: "", got: pageReport.Links[3].Text},
+		{want: "logo", got: pageReport.Links[3].Text},
+		{want: "main", got: pageReport.Links[3].Position},
 		{want: "https://example.com/", got: pageReport.Links[4].URL},
 		{want: "https://example.com/test-page/", got: pageReport.Links[5].URL},
 		{want: "0;URL='/'", got: pageReport.Refresh},
@@ -155,6 +160,7 @@ func TestPageReportHTML(t *testing.T) {
 		got  bool
 	}{
 		{want: false, got: pageReport.Links[0].External},
+		{want: true, got: pageReport.Links[3].Image},
 		{want: false, got: pageReport.ValidHeadings},
 		{want: true, got: pageReport.Noindex},
 		{want: true, got: pageReport.ExternalLinks[0].Sponsored},
@@ -208,18 +214,17 @@ func TestNoindex(t *testing.T) {
 	}
 }
 
-func TestContentLanguage(t *testing.T) {
+func TestRobotsDirectives(t *testing.T) {
 	u, err := url.Parse(testURL)
 	if err != nil {
 		fmt.Println(err)
 	}
 
-	body := []byte("<html>")
+	body := []byte(`<html><head><meta name="robots" content="index, follow, max-snippet:50"><meta name="otherbot" content="none"></head></html>`)
 	statusCode := 200
-	contentLanguage := "en-us"
 	headers := http.Header{
-		"Content-Language": []string{contentLanguage},
-		"Content-Type":     []string{"text/html"},
+		"X-Robots-Tag": []string{"googlebot: noindex", "unavailable_after: 2050-01-01"},
+		"Content-Type": []string{"text/html"},
 	}
 
 	pageReport, err := html_parser.New(u, statusCode, &headers, body)
@@ -227,27 +232,115 @@ func TestContentLanguage(t *testing.T) {
 		t.Error(err)
 	}
 
-	if pageReport.Lang != contentLanguage {
-		t.Errorf("ContentLanguage: %s != %s", pageReport.Lang, contentLanguage)
+	if len(pageReport.RobotsDirectives) != 6 {
+		t.Errorf("RobotsDirectives: %d != 6", len(pageReport.RobotsDirectives))
+	}
+
+	if pageReport.Noindex || pageReport.Nofollow || pageReport.RobotsConflict {
+		t.Error("Generic robots directives should be indexable and without conflicts")
+	}
+
+	if pageReport.Robots != "unavailable_after:2050-01-01, index, follow, max-snippet:50" {
+		t.Errorf("Robots: %s", pageReport.Robots)
+	}
+
+	html_parser.EvaluateRobots(pageReport, "Googlebot")
+	if !pageReport.Noindex || pageReport.Nofollow || !pageReport.RobotsConflict {
+		t.Error("Googlebot robots directives should be noindex with conflicts")
+	}
+
+	html_parser.EvaluateRobots(pageReport, "otherbot")
+	if !pageReport.Noindex || !pageReport.Nofollow || !pageReport.RobotsConflict {
+		t.Error("Otherbot robots directives should be noindex and nofollow with conflicts")
+	}
+
+	pageReport, err = html_parser.NewWithOptions(u, statusCode, &headers, body, &html_parser.Options{Bot: "Googlebot"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !pageReport.Noindex || pageReport.Nofollow || !pageReport.RobotsConflict {
+		t.Error("The options bot robots directives should be noindex with conflicts")
 	}
 }
 
-func TestHreflangHeaders(t *testing.T) {
+func TestStructuredData(t *testing.T) {
 	u, err := url.Parse(testURL)
 	if err != nil {
 		fmt.Println(err)
 	}
 
-	linkHeader := `
-		<https://example.com/file.pdf>; rel="alternate"; hreflang="en",
-		<https://de-ch.example.com/file.pdf>; rel="alternate"; hreflang="de-ch",
-		<https://de.example.com/file.pdf>; rel="alternate"; hreflang="de"
-	`
+	body := []byte(`<html><head>
+		<script type="application/ld+json">{"@context": "https://schema.org", "@graph": [{"@type": "Organization", "name": "Test", "url": "/"}]}</script>
+		<script type="application/ld+json">{"@type": "Product",</script>
+		</head><body>
+		<div itemscope itemtype="https://schema.org/Product">
+			<span itemprop="name">Test</span>
+			<div itemprop="offers" itemscope itemtype="https://schema.org/Offer"><span itemprop="price">1</span></div>
+		</div>
+		<ol vocab="https://schema.org/" typeof="BreadcrumbList"><li property="itemListElement" typeof="ListItem"><span property="name">Home</span></li></ol>
+		</body></html>`)
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
 
-	body := []byte("<html>")
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(pageReport.StructuredData) != 4 {
+		t.Fatalf("StructuredData: %d != 4", len(pageReport.StructuredData))
+	}
+
+	sd := pageReport.StructuredData
+
+	stable := []struct {
+		want string
+		got  string
+	}{
+		{want: "json-ld", got: sd[0].Format},
+		{want: "Organization", got: sd[0].Type},
+		{want: "name,url", got: strings.Join(sd[0].Properties, ",")},
+		{want: "json-ld", got: sd[1].Format},
+		{want: "microdata", got: sd[2].Format},
+		{want: "Product", got: sd[2].Type},
+		{want: "name,offers", got: strings.Join(sd[2].Properties, ",")},
+		{want: "rdfa", got: sd[3].Format},
+		{want: "BreadcrumbList", got: sd[3].Type},
+		{want: "itemListElement", got: strings.Join(sd[3].Properties, ",")},
+	}
+
+	for _, v := range stable {
+		if v.got != v.want {
+			t.Errorf("want: %s got: %s", v.want, v.got)
+		}
+	}
+
+	if sd[1].Valid || !sd[0].Valid {
+		t.Error("Only the JSON-LD script with invalid JSON should not be valid")
+	}
+}
+
+func TestStructuredDataValidation(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(`<html><head></head><body prefix="og: https://ogp.me/ns#">
+		<div itemscope itemtype="https://schema.org/Product" itemref="offer"><span itemprop="name">Test</span></div>
+		<div id="offer" itemprop="offers" itemscope itemtype="https://schema.org/Offer"></div>
+		<div itemscope itemtype="Product"><span itemprop="name">Test</span></div>
+		<div itemscope itemtype="https://schema.org/Product" itemref="missing"></div>
+		<div typeof="schema:Product"><span property="schema:name">Test</span></div>
+		<div typeof="og:Website"></div>
+		<div typeof="Product"><span property="name">Test</span></div>
+		<div typeof="ex:Product"></div>
+		</body></html>`)
 	statusCode := 200
 	headers := http.Header{
-		"Link":         []string{linkHeader},
 		"Content-Type": []string{"text/html"},
 	}
 
@@ -256,39 +349,108 @@ func TestHreflangHeaders(t *testing.T) {
 		t.Error(err)
 	}
 
-	if len(pageReport.Hreflangs) != 3 {
-		t.Errorf("HreflangHeader: %d != 3", len(pageReport.Hreflangs))
+	want := []bool{true, false, false, true, true, false, false}
+	if len(pageReport.StructuredData) != len(want) {
+		t.Fatalf("StructuredData: %d != %d", len(pageReport.StructuredData), len(want))
 	}
 
-	if pageReport.Hreflangs[0].URL != "https://example.com/file.pdf" || pageReport.Hreflangs[0].Lang != "en" {
-		t.Errorf("HreflangHeader: Hreflangs[0]: %v ", pageReport.Hreflangs[0])
+	for i, v := range want {
+		if pageReport.StructuredData[i].Valid != v {
+			t.Errorf("StructuredData %d %s: valid should be %v", i, pageReport.StructuredData[i].Format, v)
+		}
 	}
+}
 
-	if pageReport.Hreflangs[1].URL != "https://de-ch.example.com/file.pdf" || pageReport.Hreflangs[1].Lang != "de-ch" {
-		t.Errorf("HreflangHeader: Hreflangs[1]: %v ", pageReport.Hreflangs[1])
+func TestSocialTags(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
 	}
 
-	if pageReport.Hreflangs[2].URL != "https://de.example.com/file.pdf" || pageReport.Hreflangs[2].Lang != "de" {
-		t.Errorf("HreflangHeader: Hreflangs[2]: %v ", pageReport.Hreflangs[2])
+	body := []byte(`<html><head>
+		<meta property="og:title" content="OG Title">
+		<meta property="og:image" content="/img/og.jpg">
+		<meta property="og:url" content="https://example.com/test-page/#top">
+		<meta name="twitter:card" content="summary_large_image">
+		<meta name="twitter:title" content="Twitter Title">
+		</head></html>`)
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	stable := []struct {
+		want string
+		got  string
+	}{
+		{want: "OG Title", got: pageReport.SocialTags.OGTitle},
+		{want: "/img/og.jpg", got: pageReport.SocialTags.OGImage},
+		{want: "https://example.com/img/og.jpg", got: pageReport.SocialTags.OGImageURL},
+		{want: "https://example.com/test-page/", got: pageReport.SocialTags.OGURL},
+		{want: "summary_large_image", got: pageReport.SocialTags.TwitterCard},
+		{want: "Twitter Title", got: pageReport.SocialTags.TwitterTitle},
+	}
+
+	for _, v := range stable {
+		if v.got != v.want {
+			t.Errorf("want: %s got: %s", v.want, v.got)
+		}
 	}
 }
 
-func TestCanonicalHeaders(t *testing.T) {
+func TestHeadings(t *testing.T) {
 	u, err := url.Parse(testURL)
 	if err != nil {
 		fmt.Println(err)
 	}
 
-	linkHeader := `
-		<https://example.com/canonical>; rel="canonical",
-		<https://de-ch.example.com/file.pdf>; rel="alternate"; hreflang="de-ch",
-		<https://de.example.com/file.pdf>; rel="alternate"; hreflang="de"
-	`
+	body := []byte(`<html><body><h1>Title</h1><div><h2>Subtitle</h2><h4></h4></div><h3>Section</h3></body></html>`)
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
 
-	body := []byte("<html>")
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []models.Heading{
+		{Level: 1, Text: "Title"},
+		{Level: 2, Text: "Subtitle"},
+		{Level: 4, Text: "", SkipsLevel: true},
+		{Level: 3, Text: "Section"},
+	}
+
+	if len(pageReport.Headings) != len(want) {
+		t.Fatalf("Headings: %d != %d", len(pageReport.Headings), len(want))
+	}
+
+	for i, h := range want {
+		if pageReport.Headings[i] != h {
+			t.Errorf("want: %+v got: %+v", h, pageReport.Headings[i])
+		}
+	}
+
+	if pageReport.ValidHeadings {
+		t.Error("ValidHeadings should be false")
+	}
+}
+
+func TestHeadingsFirstLevel(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(`<html><body><h2>Subtitle</h2><h3>Section</h3><h2>Subtitle</h2></body></html>`)
 	statusCode := 200
 	headers := http.Header{
-		"Link":         []string{linkHeader},
 		"Content-Type": []string{"text/html"},
 	}
 
@@ -297,18 +459,25 @@ func TestCanonicalHeaders(t *testing.T) {
 		t.Error(err)
 	}
 
-	if pageReport.Canonical != "https://example.com/canonical" {
-		t.Errorf("Canonical headers: %s != https://example.com/canonical", pageReport.Canonical)
+	for _, h := range pageReport.Headings {
+		if h.SkipsLevel {
+			t.Errorf("heading %+v should not skip levels", h)
+		}
+	}
+
+	if !pageReport.ValidHeadings {
+		t.Error("ValidHeadings should be true")
 	}
 }
 
-func TestNoBodyTag(t *testing.T) {
+// Test the first heading follows an implied h1, so a page starting with an h3 heading skips levels.
+func TestHeadingsFirstLevelSkipped(t *testing.T) {
 	u, err := url.Parse(testURL)
 	if err != nil {
 		fmt.Println(err)
 	}
 
-	body := []byte("<html><frameset></frameset></html>")
+	body := []byte(`<html><body><h3>Section</h3><h4>Subsection</h4></body></html>`)
 	statusCode := 200
 	headers := http.Header{
 		"Content-Type": []string{"text/html"},
@@ -319,23 +488,79 @@ func TestNoBodyTag(t *testing.T) {
 		t.Error(err)
 	}
 
-	if pageReport.Words != 0 {
-		t.Errorf("NoBody: %d != 0", pageReport.Words)
+	want := []models.Heading{
+		{Level: 3, Text: "Section", SkipsLevel: true},
+		{Level: 4, Text: "Subsection"},
+	}
+
+	if len(pageReport.Headings) != len(want) {
+		t.Fatalf("Headings: %d != %d", len(pageReport.Headings), len(want))
+	}
+
+	for i, h := range want {
+		if pageReport.Headings[i] != h {
+			t.Errorf("want: %+v got: %+v", h, pageReport.Headings[i])
+		}
+	}
+
+	if pageReport.ValidHeadings {
+		t.Error("ValidHeadings should be false")
 	}
 }
 
-func TestInvalidLang(t *testing.T) {
+func TestContentFingerprint(t *testing.T) {
 	u, err := url.Parse(testURL)
 	if err != nil {
 		fmt.Println(err)
 	}
 
-	contentType := "text/html"
 	statusCode := 200
-	body := []byte("<html lang=\"es-invalid-lang\">")
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	a := []byte(`<html><body><nav><a href="/">Home</a></nav><main><p>Some page content.</p></main></body></html>`)
+	b := []byte(`<html><body><nav><a href="/about">About us</a></nav><main><p>Some page, content</p></main><footer>Footer</footer></body></html>`)
+	c := []byte(`<html><body><main><p>Different page content</p></main></body></html>`)
+
+	pa, err := html_parser.New(u, statusCode, &headers, a)
+	if err != nil {
+		t.Error(err)
+	}
+
+	pb, err := html_parser.New(u, statusCode, &headers, b)
+	if err != nil {
+		t.Error(err)
+	}
+
+	pc, err := html_parser.New(u, statusCode, &headers, c)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pa.ContentHash == "" || pa.ContentHash != pb.ContentHash {
+		t.Errorf("ContentHash should be equal: %s %s", pa.ContentHash, pb.ContentHash)
+	}
+
+	if pa.SimHash != pb.SimHash {
+		t.Errorf("SimHash should be equal: %d %d", pa.SimHash, pb.SimHash)
+	}
+
+	if pa.ContentHash == pc.ContentHash {
+		t.Errorf("ContentHash should be different: %s %s", pa.ContentHash, pc.ContentHash)
+	}
+}
+
+func TestReadability(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
 
+	body := []byte(`<html lang="en-US"><head><script>var a = 1;</script></head><body><h1>The big cat</h1><p>The cat sat on the mat. The dog ran.</p></body></html>`)
+	statusCode := 200
 	headers := http.Header{
-		"Content-Type": []string{contentType},
+		"Content-Type": []string{"text/html"},
 	}
 
 	pageReport, err := html_parser.New(u, statusCode, &headers, body)
@@ -343,7 +568,968 @@ func TestInvalidLang(t *testing.T) {
 		t.Error(err)
 	}
 
-	if pageReport.ValidLang == true {
-		t.Error("ValidLang != false")
+	if pageReport.Sentences != 3 {
+		t.Errorf("Sentences: %d != 3", pageReport.Sentences)
+	}
+
+	if pageReport.AvgSentenceLength != 4 {
+		t.Errorf("AvgSentenceLength: %f != 4", pageReport.AvgSentenceLength)
+	}
+
+	if pageReport.TextRatio <= 0 || pageReport.TextRatio >= 100 {
+		t.Errorf("TextRatio: %f", pageReport.TextRatio)
+	}
+
+	if !pageReport.ValidReadability || pageReport.Readability != 100 {
+		t.Errorf("Readability: %f valid: %t", pageReport.Readability, pageReport.ValidReadability)
+	}
+
+	body = []byte(`<html lang="xx"><body><p>Some text.</p></body></html>`)
+	pageReport, err = html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pageReport.ValidReadability {
+		t.Error("Readability should not be valid for languages without a formula")
+	}
+}
+
+func TestExtractors(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(`<html><head><script>gtag('config', 'GTM-AB12CD');</script></head><body>
+		<div class="product main"><span itemprop="price">10.50</span><a rel="author" href="/john">John</a></div>
+		<ul><li>One</li><li>Two</li></ul>
+		<span class="stock">In stock</span>
+	</body></html>`)
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	extractors := []models.Extractor{
+		{Name: "Price", Type: models.ExtractorXPath, Expression: "//span[@itemprop='price']"},
+		{Name: "Author", Type: models.ExtractorCSS, Expression: "div.product > a[rel=author]"},
+		{Name: "Author URL", Type: models.ExtractorCSS, Expression: "div.main a::attr(href)"},
+		{Name: "Items", Type: models.ExtractorCSS, Expression: "ul li"},
+		{Name: "Last item", Type: models.ExtractorCSS, Expression: "li:last-child"},
+		{Name: "Stock", Type: models.ExtractorCSS, Expression: "ul + span.stock"},
+		{Name: "GTM", Type: models.ExtractorRegex, Expression: `'(GTM-[A-Z0-9]+)'`},
+		{Name: "Missing", Type: models.ExtractorCSS, Expression: "#missing"},
+	}
+
+	pageReport, err := html_parser.NewWithOptions(u, statusCode, &headers, body, &html_parser.Options{Extractors: html_parser.CompileExtractors(extractors)})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []models.Extraction{
+		{Name: "Price", Value: "10.50"},
+		{Name: "Author", Value: "John"},
+		{Name: "Author URL", Value: "/john"},
+		{Name: "Items", Value: "One | Two"},
+		{Name: "Last item", Value: "Two"},
+		{Name: "Stock", Value: "In stock"},
+		{Name: "GTM", Value: "GTM-AB12CD"},
+	}
+
+	if len(pageReport.Extractions) != len(want) {
+		t.Fatalf("Extractions: %d != %d %+v", len(pageReport.Extractions), len(want), pageReport.Extractions)
+	}
+
+	for i, e := range want {
+		if pageReport.Extractions[i] != e {
+			t.Errorf("want: %+v got: %+v", e, pageReport.Extractions[i])
+		}
+	}
+}
+
+func TestExtractionsTruncated(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte("<html><body><p>" + strings.Repeat("ñ", 3000) + "</p></body></html>")
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	extractors := html_parser.CompileExtractors([]models.Extractor{
+		{Name: "Text", Type: models.ExtractorCSS, Expression: "p"},
+	})
+
+	pageReport, err := html_parser.NewWithOptions(u, statusCode, &headers, body, &html_parser.Options{Extractors: extractors})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(pageReport.Extractions) != 1 {
+		t.Fatalf("Extractions: %d != 1", len(pageReport.Extractions))
+	}
+
+	if v := pageReport.Extractions[0].Value; v != strings.Repeat("ñ", 2048) {
+		t.Errorf("Extraction should be truncated to 2048 characters: %d", len([]rune(v)))
+	}
+}
+
+func TestContentLanguage(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte("<html>")
+	statusCode := 200
+	contentLanguage := "en-us"
+	headers := http.Header{
+		"Content-Language": []string{contentLanguage},
+		"Content-Type":     []string{"text/html"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pageReport.Lang != contentLanguage {
+		t.Errorf("ContentLanguage: %s != %s", pageReport.Lang, contentLanguage)
+	}
+}
+
+func TestHreflangHeaders(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	linkHeader := `
+		<https://example.com/file.pdf>; rel="alternate"; hreflang="en",
+		<https://de-ch.example.com/file.pdf>; rel="alternate"; hreflang="de-ch",
+		<https://de.example.com/file.pdf>; rel="alternate"; hreflang="de"
+	`
+
+	body := []byte("<html>")
+	statusCode := 200
+	headers := http.Header{
+		"Link":         []string{linkHeader},
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(pageReport.Hreflangs) != 3 {
+		t.Errorf("HreflangHeader: %d != 3", len(pageReport.Hreflangs))
+	}
+
+	if pageReport.Hreflangs[0].URL != "https://example.com/file.pdf" || pageReport.Hreflangs[0].Lang != "en" {
+		t.Errorf("HreflangHeader: Hreflangs[0]: %v ", pageReport.Hreflangs[0])
+	}
+
+	if pageReport.Hreflangs[1].URL != "https://de-ch.example.com/file.pdf" || pageReport.Hreflangs[1].Lang != "de-ch" {
+		t.Errorf("HreflangHeader: Hreflangs[1]: %v ", pageReport.Hreflangs[1])
+	}
+
+	if pageReport.Hreflangs[2].URL != "https://de.example.com/file.pdf" || pageReport.Hreflangs[2].Lang != "de" {
+		t.Errorf("HreflangHeader: Hreflangs[2]: %v ", pageReport.Hreflangs[2])
+	}
+}
+
+func TestCanonicalHeaders(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	linkHeader := `
+		<https://example.com/canonical>; rel="canonical",
+		<https://de-ch.example.com/file.pdf>; rel="alternate"; hreflang="de-ch",
+		<https://de.example.com/file.pdf>; rel="alternate"; hreflang="de"
+	`
+
+	body := []byte("<html>")
+	statusCode := 200
+	headers := http.Header{
+		"Link":         []string{linkHeader},
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pageReport.Canonical != "https://example.com/canonical" {
+		t.Errorf("Canonical headers: %s != https://example.com/canonical", pageReport.Canonical)
+	}
+}
+
+func TestCanonicals(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(`<html><head>
+		<link rel="canonical" href="/test-page/#top">
+		<link rel="canonical" href="https://example.org/test-page/?page=1">
+		<link rel="canonical" href="javascript:void(0)">
+	</head></html>`)
+	statusCode := 200
+	headers := http.Header{
+		"Link":         []string{`</canonical>; rel="canonical"`, `<https://example.com/style.css>; rel="preload"`},
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pageReport.Canonical != "https://example.com/test-page/" {
+		t.Errorf("Canonical: %s != https://example.com/test-page/", pageReport.Canonical)
+	}
+
+	want := []models.Canonical{
+		{URL: "https://example.com/test-page/#top", Source: models.CanonicalSourceHTML},
+		{URL: "https://example.org/test-page/?page=1", Source: models.CanonicalSourceHTML},
+		{URL: "https://example.com/canonical", Source: models.CanonicalSourceHeader},
+	}
+
+	if len(pageReport.Canonicals) != len(want) {
+		t.Fatalf("Canonicals: %+v != %+v", pageReport.Canonicals, want)
+	}
+
+	for i, c := range want {
+		if pageReport.Canonicals[i] != c {
+			t.Errorf("Canonical %d: %+v != %+v", i, pageReport.Canonicals[i], c)
+		}
+	}
+}
+
+func TestNoBodyTag(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte("<html><frameset></frameset></html>")
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pageReport.Words != 0 {
+		t.Errorf("NoBody: %d != 0", pageReport.Words)
+	}
+}
+
+func TestInvalidLang(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	contentType := "text/html"
+	statusCode := 200
+	body := []byte("<html lang=\"es-invalid-lang\">")
+
+	headers := http.Header{
+		"Content-Type": []string{contentType},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pageReport.ValidLang == true {
+		t.Error("ValidLang != false")
+	}
+}
+
+func TestSearchRules(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(`<html><head><script>gtag('config', 'G-AB12CD');</script></head><body>
+		<h1>Lorem   ipsum</h1>
+		<p>Out of stock</p>
+		<!-- TODO: add the footer -->
+	</body></html>`)
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	rules := []models.SearchRule{
+		{Name: "Analytics", Pattern: "gtag(", Type: models.SearchSubstring, Mode: models.SearchNotContains, Target: models.SearchHTML},
+		{Name: "Lorem ipsum", Pattern: "Lorem ipsum", Type: models.SearchSubstring, Mode: models.SearchContains, Target: models.SearchText},
+		{Name: "Out of stock", Pattern: `(?i)out\s+of\s+stock`, Type: models.SearchRegex, Mode: models.SearchContains, Target: models.SearchText},
+		{Name: "Visible gtag", Pattern: "gtag", Type: models.SearchSubstring, Mode: models.SearchContains, Target: models.SearchText},
+		{Name: "TODO comment", Pattern: "<!-- TODO", Type: models.SearchSubstring, Mode: models.SearchContains, Target: models.SearchHTML},
+		{Name: "Missing footer", Pattern: "<footer", Type: models.SearchSubstring, Mode: models.SearchNotContains, Target: models.SearchHTML},
+	}
+
+	pageReport, err := html_parser.NewWithOptions(u, statusCode, &headers, body, &html_parser.Options{SearchRules: html_parser.CompileSearchRules(rules)})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []string{"Lorem ipsum", "Out of stock", "TODO comment", "Missing footer"}
+	if len(pageReport.CustomIssues) != len(want) {
+		t.Fatalf("CustomIssues: %d != %d %+v", len(pageReport.CustomIssues), len(want), pageReport.CustomIssues)
+	}
+
+	for i, v := range want {
+		if pageReport.CustomIssues[i] != v {
+			t.Errorf("CustomIssues %d: %s != %s", i, pageReport.CustomIssues[i], v)
+		}
+	}
+}
+
+func TestLinkAnchorText(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(`<html><body>
+		<a href="/text" aria-label="Label">Text</a>
+		<a href="/label" aria-label="Label"><i class="icon"></i></a>
+		<a href="/labelledby" aria-labelledby="l1 l2"><i class="icon"></i></a>
+		<a href="/title" title="Title"><i class="icon"></i></a>
+		<a href="/empty"><i class="icon"></i></a>
+		<span id="l1">Labelled</span><span id="l2">by</span>
+	</body></html>`)
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	// The aria-label, aria-labelledby and title attributes are not anchor text.
+	want := []string{"Text", "", "", "", ""}
+	if len(pageReport.Links) != len(want) {
+		t.Fatalf("Links: %d != %d", len(pageReport.Links), len(want))
+	}
+
+	for i, w := range want {
+		if pageReport.Links[i].Text != w {
+			t.Errorf("Link %s text: %s != %s", pageReport.Links[i].URL, pageReport.Links[i].Text, w)
+		}
+	}
+}
+
+func TestLinkContext(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(`<html><body>
+		<header><nav><a href="/nav">Nav</a></nav><a href="/logo"><img src="/logo.png" alt="Logo"></a></header>
+		<div role="complementary"><a href="/related" title="Related post">Related</a></div>
+		<main><a href="https://example.org" target="_blank">External</a><a href="/empty"></a></main>
+		<footer><a href="https://example.net" target="_blank" rel="noopener">Partner</a></footer>
+	</body></html>`)
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	links := append(pageReport.Links, pageReport.ExternalLinks...)
+	want := []models.Link{
+		{URL: "https://example.com/nav", Text: "Nav", Position: models.LinkPositionNav},
+		{URL: "https://example.com/logo", Text: "Logo", Position: models.LinkPositionHeader, Image: true},
+		{URL: "https://example.com/related", Text: "Related", Position: models.LinkPositionAside, Title: "Related post"},
+		{URL: "https://example.com/empty", Text: "", Position: models.LinkPositionMain},
+		{URL: "https://example.org/", Text: "External", Position: models.LinkPositionMain, BlankNoOpener: true},
+		{URL: "https://example.net/", Text: "Partner", Position: models.LinkPositionFooter},
+	}
+
+	if len(links) != len(want) {
+		t.Fatalf("Links: %d != %d", len(links), len(want))
+	}
+
+	for i, w := range want {
+		l := links[i]
+		if l.URL != w.URL || l.Text != w.Text || l.Position != w.Position || l.Title != w.Title ||
+			l.Image != w.Image || l.BlankNoOpener != w.BlankNoOpener {
+			t.Errorf("Link %d: %+v != %+v", i, l, w)
+		}
+	}
+}
+
+func TestLinkRelations(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(`<html><head>
+		<link rel="prev" href="/page/1">
+		<link rel="next" href="/page/3">
+		<link rel="amphtml" href="/amp/page/2">
+		<link rel="alternate" media="only screen and (max-width: 640px)" href="https://m.example.com/page/2">
+		<link rel="alternate" hreflang="es" media="screen" href="/es/page/2">
+		<link rel="stylesheet" href="/style.css">
+	</head><body></body></html>`)
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []models.LinkRelation{
+		{Rel: models.RelPrev, URL: "https://example.com/page/1"},
+		{Rel: models.RelNext, URL: "https://example.com/page/3"},
+		{Rel: models.RelAmpHTML, URL: "https://example.com/amp/page/2"},
+		{Rel: models.RelAlternate, URL: "https://m.example.com/page/2", Media: "only screen and (max-width: 640px)"},
+	}
+
+	if len(pageReport.LinkRelations) != len(want) {
+		t.Fatalf("LinkRelations: %d != %d %+v", len(pageReport.LinkRelations), len(want), pageReport.LinkRelations)
+	}
+
+	for i, w := range want {
+		if pageReport.LinkRelations[i] != w {
+			t.Errorf("LinkRelation %d: %+v != %+v", i, pageReport.LinkRelations[i], w)
+		}
+	}
+}
+
+func TestImageAttributes(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(`<html><body>
+		<img src="/hero.jpg" srcset="/hero-2x.jpg 2x" alt="Hero" width="800" height="400" loading="lazy" decoding="async">
+		<img src="/logo.png" alt="Logo">
+		<img src="/banner.png" alt="Banner" width="10000000000000000000px" height="ñññññññññññññññññ">
+	</body></html>`)
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []models.Image{
+		{URL: "https://example.com/hero.jpg", Alt: "Hero", Width: "800", Height: "400", SrcSet: true},
+		{URL: "https://example.com/hero-2x.jpg", Alt: "Hero", Width: "800", Height: "400", SrcSet: true},
+		{URL: "https://example.com/logo.png", Alt: "Logo"},
+		{URL: "https://example.com/banner.png", Alt: "Banner", Width: "1000000000000000", Height: "ññññññññññññññññ"},
+	}
+
+	if len(pageReport.Images) != len(want) {
+		t.Fatalf("Images: %d != %d", len(pageReport.Images), len(want))
+	}
+
+	for i, w := range want {
+		if pageReport.Images[i] != w {
+			t.Errorf("Image %d: %+v != %+v", i, pageReport.Images[i], w)
+		}
+	}
+}
+
+func TestPageSetup(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(`<html><head>
+		<meta http-equiv="Content-Type" content="text/html; charset=ISO-8859-1">
+		<meta name="viewport" content="width=device-width, initial-scale=1">
+		<meta name="theme-color" content="#4285f4">
+		<link rel="shortcut icon" href="/favicon.ico">
+		<link rel="apple-touch-icon" href="/apple-touch-icon.png">
+	</head><body></body></html>`)
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html; charset=UTF8"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := models.PageSetup{
+		Viewport:       "width=device-width, initial-scale=1",
+		MetaCharset:    "iso-8859-1",
+		HeaderCharset:  "utf-8",
+		Favicon:        "https://example.com/favicon.ico",
+		AppleTouchIcon: "https://example.com/apple-touch-icon.png",
+		ThemeColor:     "#4285f4",
+	}
+
+	if pageReport.PageSetup != want {
+		t.Errorf("PageSetup: %+v != %+v", pageReport.PageSetup, want)
+	}
+}
+
+func TestHeadElements(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	table := []struct {
+		body string
+		want models.HeadElements
+	}{
+		{
+			body: `<html><head>
+				<title>Title</title>
+				<script>var a = "<div>";</script>
+				<noscript><img src="/pixel.gif"></noscript>
+				<link rel="canonical" href="/">
+			</head><body><div id="a"><svg><title>Icon</title></svg></div></body></html>`,
+			want: models.HeadElements{Titles: 1, Canonicals: 1},
+		},
+		{
+			body: `<html><head>
+				<title>Title</title>
+				<img src="/pixel.gif">
+				<link rel="canonical" href="/">
+				<link rel="alternate" hreflang="es" href="/es">
+				<meta name="robots" content="noindex">
+				<title>Second title</title>
+			</head><body><div id="a"></div><p id="a"></p></body></html>`,
+			want: models.HeadElements{
+				BreakingElement: "img",
+				TagsAfterBreak:  []string{"canonical", "hreflang", "robots", "title"},
+				DuplicateIds:    []string{"a"},
+				Titles:          2,
+				Canonicals:      1,
+			},
+		},
+		{
+			body: `<html><head>Text<link rel="canonical" href="/"><link rel="canonical" href="/a"></head><body></body></html>`,
+			want: models.HeadElements{
+				BreakingElement: "#text",
+				TagsAfterBreak:  []string{"canonical"},
+				Canonicals:      2,
+			},
+		},
+	}
+
+	for _, tt := range table {
+		pageReport, err := html_parser.New(u, statusCode, &headers, []byte(tt.body))
+		if err != nil {
+			t.Error(err)
+		}
+
+		h := pageReport.HeadElements
+		if h.BreakingElement != tt.want.BreakingElement {
+			t.Errorf("BreakingElement: %s != %s", h.BreakingElement, tt.want.BreakingElement)
+		}
+
+		if strings.Join(h.TagsAfterBreak, ",") != strings.Join(tt.want.TagsAfterBreak, ",") {
+			t.Errorf("TagsAfterBreak: %v != %v", h.TagsAfterBreak, tt.want.TagsAfterBreak)
+		}
+
+		if strings.Join(h.DuplicateIds, ",") != strings.Join(tt.want.DuplicateIds, ",") {
+			t.Errorf("DuplicateIds: %v != %v", h.DuplicateIds, tt.want.DuplicateIds)
+		}
+
+		if h.Titles != tt.want.Titles {
+			t.Errorf("Titles: %d != %d", h.Titles, tt.want.Titles)
+		}
+
+		if h.Canonicals != tt.want.Canonicals {
+			t.Errorf("Canonicals: %d != %d", h.Canonicals, tt.want.Canonicals)
+		}
+	}
+}
+
+func TestAccessibility(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(`<html><head><title>Accessibility</title></head><body>
+		<form>
+			<label for="name">Name</label><input id="name" type="text">
+			<label>Email <input type="email"></label>
+			<input type="search" aria-label="Search">
+			<input type="hidden" name="token">
+			<input type="text" placeholder="Phone">
+			<textarea></textarea>
+			<input type="submit">
+			<input type="button">
+			<input type="image" src="/send.png">
+			<button>Send</button>
+			<button><svg aria-label="Close"></svg></button>
+			<button></button>
+		</form>
+		<a href="/">Home</a>
+		<a href="/about"><img src="/about.png" alt="About"></a>
+		<a href="/contact" aria-label="Contact"><i class="icon"></i></a>
+		<a href="/empty"><img src="/empty.png"></a>
+		<a href="/labelledby" aria-labelledby="contact-label"><i class="icon"></i></a>
+		<a href="/missing-label" aria-labelledby="missing"><i class="icon"></i></a>
+		<a href="javascript:void(0)"><i class="icon"></i></a>
+		<a href="mailto:info@example.com" title="Email"><i class="icon"></i></a>
+		<span id="contact-label">Contact us</span>
+		<img src="/decorative.png" alt="">
+		<img src="/presentation.png" role="presentation">
+		<img src="/hidden.png" aria-hidden="true">
+		<img src="/chart.png" aria-label="Chart">
+		<img src="/photo.png">
+		<div tabindex="0"></div>
+		<div tabindex="-1"></div>
+		<div tabindex="3"></div>
+		<iframe src="/video" title="Video"></iframe>
+		<iframe src="/ads"></iframe>
+		<iframe src="/tracking" aria-hidden="true"></iframe>
+	</body></html>`)
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := models.Accessibility{
+		InputsWithoutLabel:  2,
+		ButtonsWithoutName:  3,
+		LinksWithoutName:    3,
+		PositiveTabindex:    1,
+		IframesWithoutTitle: 1,
+		ImagesWithoutAlt:    2,
+		MissingLang:         true,
+	}
+
+	if pageReport.Accessibility != want {
+		t.Errorf("Accessibility: %+v != %+v", pageReport.Accessibility, want)
+	}
+}
+
+func TestPDF(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	var content bytes.Buffer
+	w := zlib.NewWriter(&content)
+	w.Write([]byte("BT /F1 12 Tf 72 720 Td (Quarterly \\(annual\\) report) Tj 0 -14 Td [(for)-250(the)] TJ ET"))
+	w.Close()
+
+	body := []byte("%PDF-1.4\n" +
+		"1 0 obj\n<< /Type /Catalog /Pages 2 0 R /Lang (en-GB) >>\nendobj\n" +
+		"2 0 obj\n<< /Type /Pages /Kids [3 0 R 4 0 R] /Count 2 >>\nendobj\n" +
+		"3 0 obj\n<< /Type /Page /Parent 2 0 R /Contents 5 0 R /Annots [6 0 R 7 0 R 8 0 R] >>\nendobj\n" +
+		"4 0 obj\n<< /Type/Page /Parent 2 0 R >>\nendobj\n" +
+		"5 0 obj\n<< /Length " + fmt.Sprint(content.Len()) + " /Filter /FlateDecode >>\nstream\n" + content.String() + "\nendstream\nendobj\n" +
+		"6 0 obj\n<< /Type /Annot /Subtype /Link /A << /S /URI /URI (/contact) >> >>\nendobj\n" +
+		"7 0 obj\n<< /Type /Annot /Subtype /Link /A << /S /URI /URI (https://example.org/) >> >>\nendobj\n" +
+		"8 0 obj\n<< /Type /Annot /Subtype /Link /A << /S /URI /URI (https://example.com/contact#form) >> >>\nendobj\n" +
+		"9 0 obj\n<< /Title <FEFF0050004400460020005400690074006C0065> >>\nendobj\n" +
+		"trailer\n<< /Root 1 0 R /Info 9 0 R >>\n%%EOF")
+
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type": []string{"application/pdf"},
+		"X-Robots-Tag": []string{"noindex"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pageReport.Title != "PDF Title" {
+		t.Errorf("Title: %s != PDF Title", pageReport.Title)
+	}
+
+	if pageReport.Lang != "en-GB" {
+		t.Errorf("Lang: %s != en-GB", pageReport.Lang)
+	}
+
+	if pageReport.PDF.Pages != 2 {
+		t.Errorf("Pages: %d != 2", pageReport.PDF.Pages)
+	}
+
+	if pageReport.Words != 5 {
+		t.Errorf("Words: %d != 5", pageReport.Words)
+	}
+
+	if len(pageReport.Links) != 1 || pageReport.Links[0].URL != "https://example.com/contact" {
+		t.Errorf("Links: %+v", pageReport.Links)
+	}
+
+	if len(pageReport.ExternalLinks) != 1 || pageReport.ExternalLinks[0].URL != "https://example.org/" {
+		t.Errorf("ExternalLinks: %+v", pageReport.ExternalLinks)
+	}
+
+	if !pageReport.Noindex {
+		t.Error("Noindex: X-Robots-Tag noindex header was not applied")
+	}
+}
+
+// Test a PDF document created with a PDF library, the fixture was generated with go-pdf/fpdf.
+func TestPDFDocument(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body, err := ioutil.ReadFile("./testdata/document.pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	headers := http.Header{
+		"Content-Type": []string{"application/pdf"},
+	}
+
+	pageReport, err := html_parser.New(u, 200, &headers, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pageReport.Title != "Seonaut PDF Fixture" {
+		t.Errorf("Title: %s != Seonaut PDF Fixture", pageReport.Title)
+	}
+
+	if pageReport.Lang != "en-US" {
+		t.Errorf("Lang: %s != en-US", pageReport.Lang)
+	}
+
+	if pageReport.PDF.Pages != 3 {
+		t.Errorf("Pages: %d != 3", pageReport.PDF.Pages)
+	}
+
+	if pageReport.Words != 49 {
+		t.Errorf("Words: %d != 49", pageReport.Words)
+	}
+
+	if len(pageReport.Links) != 2 || pageReport.Links[0].URL != "https://example.com/linked-page" || pageReport.Links[1].URL != "https://example.com/about" {
+		t.Errorf("Links: %+v", pageReport.Links)
+	}
+
+	if len(pageReport.ExternalLinks) != 0 {
+		t.Errorf("ExternalLinks: %+v", pageReport.ExternalLinks)
+	}
+
+	// Add an incremental update replacing the second page, which must be counted only once.
+	update := "\n5 0 obj\n<</Type /Page /Parent 1 0 R /Resources 2 0 R /Contents 6 0 R>>\nendobj\n" +
+		"trailer\n<< /Root 11 0 R /Info 10 0 R >>\n%%EOF\n"
+	pageReport, err = html_parser.New(u, 200, &headers, append(body, []byte(update)...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pageReport.PDF.Pages != 3 {
+		t.Errorf("Updated pages: %d != 3", pageReport.PDF.Pages)
+	}
+
+	if pageReport.Words != 49 {
+		t.Errorf("Updated words: %d != 49", pageReport.Words)
+	}
+}
+
+// Test the objects compressed in object streams are counted once, and only the
+// streams referenced from the pages contents are used as text.
+func TestPDFObjectStreams(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	compress := func(s string) string {
+		var b bytes.Buffer
+		w := zlib.NewWriter(&b)
+		w.Write([]byte(s))
+		w.Close()
+
+		return b.String()
+	}
+
+	objects := "<< /Type /Pages /Kids [3 0 R] /Count 1 >> << /Type /Page /Parent 2 0 R /Contents 5 0 R >>"
+	objStm := compress("2 0 3 44 " + objects)
+	content := compress("BT (Annual report) Tj ET")
+	form := compress("BT (Hidden form text that is not shown) Tj ET")
+
+	body := []byte("%PDF-1.5\n" +
+		"1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n" +
+		"4 0 obj\n<< /Type /ObjStm /N 2 /First 9 /Filter /FlateDecode /Length " + fmt.Sprint(len(objStm)) + " >>\nstream\n" + objStm + "\nendstream\nendobj\n" +
+		"5 0 obj\n<< /Filter /FlateDecode /Length " + fmt.Sprint(len(content)) + " >>\nstream\n" + content + "\nendstream\nendobj\n" +
+		"6 0 obj\n<< /Type /XObject /Subtype /Form /Filter /FlateDecode /Length " + fmt.Sprint(len(form)) + " >>\nstream\n" + form + "\nendstream\nendobj\n" +
+		"trailer\n<< /Root 1 0 R >>\n%%EOF")
+
+	headers := http.Header{
+		"Content-Type": []string{"application/pdf"},
+	}
+
+	pageReport, err := html_parser.New(u, 200, &headers, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pageReport.PDF.Pages != 1 {
+		t.Errorf("Pages: %d != 1", pageReport.PDF.Pages)
+	}
+
+	if pageReport.Words != 2 {
+		t.Errorf("Words: %d != 2", pageReport.Words)
+	}
+}
+
+// Test the parser doesn't panic with broken PDF documents, such as object streams with
+// negative or out of range offsets and streams with an invalid length.
+func TestBrokenPDF(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	headers := http.Header{
+		"Content-Type": []string{"application/pdf"},
+	}
+
+	objStm := func(header, objects string, first int) string {
+		stream := header + objects
+		return "4 0 obj\n<< /Type /ObjStm /N 2 /First " + fmt.Sprint(first) + " /Length " + fmt.Sprint(len(stream)) + " >>\nstream\n" + stream + "\nendstream\nendobj\n"
+	}
+
+	bodies := []string{
+		"%PDF-1.5\n" + objStm("5 -9", "<< /Type /Page >>", 4),
+		"%PDF-1.5\n" + objStm("5 0 6 -3 ", "<< /Type /Page >>", 9),
+		"%PDF-1.5\n" + objStm("5 9223372036854775807 ", "<< /Type /Page >>", 22),
+		"%PDF-1.5\n" + objStm("5 0", "<< /Type /Page >>", 100),
+		"%PDF-1.5\n1 0 obj\n<< /Length 9223372036854775807 >>\nstream\nBT (text) Tj ET\nendstream\nendobj\n",
+		"%PDF-1.5\n1 0 obj\n<< /Type /Catalog /Pages 1 0 R >>\nendobj\n2 0 obj\n<< /Kids [2 0 R] /Contents [",
+		"%PDF-1.5\n1 0 obj (\\",
+	}
+
+	for _, b := range bodies {
+		pageReport, err := html_parser.New(u, 200, &headers, []byte(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if pageReport.MediaType != "application/pdf" {
+			t.Errorf("MediaType: %s != application/pdf", pageReport.MediaType)
+		}
+	}
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte("<html><head><title>Security</title></head><body></body></html>")
+	statusCode := 200
+	headers := http.Header{
+		"Content-Type":              []string{"text/html"},
+		"Strict-Transport-Security": []string{"max-age=31536000; includeSubDomains"},
+		"Content-Security-Policy":   []string{"default-src 'self'", "frame-ancestors 'none'"},
+		"X-Frame-Options":           []string{"DENY"},
+		"X-Content-Type-Options":    []string{"nosniff"},
+		"Referrer-Policy":           []string{"no-referrer", "strict-origin-when-cross-origin"},
+	}
+
+	pageReport, err := html_parser.New(u, statusCode, &headers, body)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := models.SecurityHeaders{
+		StrictTransportSecurity: "max-age=31536000; includeSubDomains",
+		ContentSecurityPolicy:   "default-src 'self';frame-ancestors 'none'",
+		XFrameOptions:           "DENY",
+		XContentTypeOptions:     "nosniff",
+		ReferrerPolicy:          "no-referrer,strict-origin-when-cross-origin",
+	}
+
+	if pageReport.SecurityHeaders != want {
+		t.Errorf("SecurityHeaders: %+v != %+v", pageReport.SecurityHeaders, want)
+	}
+}
+
+func TestCacheHeaders(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	table := []struct {
+		headers http.Header
+		want    int64
+	}{
+		{http.Header{"Cache-Control": []string{"public, max-age=3600"}, "Expires": []string{"Thu, 01 Dec 1994 16:00:00 GMT"}}, 3600},
+		{http.Header{"Cache-Control": []string{"max-age=3600", "no-cache"}}, 0},
+		{http.Header{"Date": []string{"Mon, 01 Jan 2024 00:00:00 GMT"}, "Expires": []string{"Tue, 02 Jan 2024 00:00:00 GMT"}}, 86400},
+		{http.Header{"Expires": []string{"0"}}, 0},
+		{http.Header{}, -1},
+	}
+
+	for _, v := range table {
+		v.headers.Set("Content-Type", "text/css")
+		v.headers.Set("ETag", `"abc"`)
+		v.headers.Add("Set-Cookie", "session=1")
+
+		pageReport, err := html_parser.New(u, 200, &v.headers, []byte("body {}"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		h := pageReport.CacheHeaders
+		if h.MaxAge != v.want {
+			t.Errorf("MaxAge %v: %d != %d", v.headers, h.MaxAge, v.want)
+		}
+
+		if h.ETag != `"abc"` || !h.SetCookie {
+			t.Errorf("CacheHeaders: %+v", h)
+		}
 	}
 }
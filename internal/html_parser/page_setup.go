@@ -0,0 +1,117 @@
+package html_parser
+
+import (
+	"mime"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// Returns the page setup tags: the viewport and theme-color meta tags, the favicon and
+// apple-touch-icon links, and the charset declared in the HTML and in the HTTP headers.
+// Only the first occurrence of each tag is used.
+// ex. <meta name="viewport" content="width=device-width, initial-scale=1">
+// ex. <link rel="icon" href="/favicon.png">
+func (p *Parser) htmlPageSetup() models.PageSetup {
+	s := models.PageSetup{
+		HeaderCharset: p.headersCharset(),
+		MetaCharset:   p.htmlCharset(),
+	}
+
+	metas, err := htmlquery.QueryAll(p.doc, "//meta[@name and @content]")
+	if err == nil {
+		for _, n := range metas {
+			content := strings.TrimSpace(htmlquery.SelectAttr(n, "content"))
+			switch strings.ToLower(strings.TrimSpace(htmlquery.SelectAttr(n, "name"))) {
+			case "viewport":
+				if s.Viewport == "" {
+					s.Viewport = content
+				}
+			case "theme-color":
+				if s.ThemeColor == "" {
+					s.ThemeColor = content
+				}
+			}
+		}
+	}
+
+	links, err := htmlquery.QueryAll(p.doc, "//link[@rel and @href]")
+	if err == nil {
+		for _, n := range links {
+			rels := strings.Fields(strings.ToLower(htmlquery.SelectAttr(n, "rel")))
+			for _, rel := range rels {
+				var v *string
+				switch rel {
+				case "icon":
+					v = &s.Favicon
+				case "apple-touch-icon", "apple-touch-icon-precomposed":
+					v = &s.AppleTouchIcon
+				default:
+					continue
+				}
+
+				if *v != "" {
+					continue
+				}
+
+				u, err := p.absoluteURL(htmlquery.SelectAttr(n, "href"))
+				if err != nil {
+					continue
+				}
+
+				*v = u.String()
+			}
+		}
+	}
+
+	return s
+}
+
+// Returns the charset declared in the meta charset tag or in the http-equiv Content-Type meta tag.
+// ex. <meta charset="utf-8">
+// ex. <meta http-equiv="Content-Type" content="text/html; charset=utf-8">
+func (p *Parser) htmlCharset() string {
+	n, err := htmlquery.Query(p.doc, "//meta[@charset]")
+	if err == nil && n != nil {
+		return normalizeCharset(htmlquery.SelectAttr(n, "charset"))
+	}
+
+	metas, err := htmlquery.QueryAll(p.doc, "//meta[@http-equiv and @content]")
+	if err != nil {
+		return ""
+	}
+
+	for _, n := range metas {
+		if strings.ToLower(strings.TrimSpace(htmlquery.SelectAttr(n, "http-equiv"))) != "content-type" {
+			continue
+		}
+
+		_, params, err := mime.ParseMediaType(htmlquery.SelectAttr(n, "content"))
+		if err == nil {
+			return normalizeCharset(params["charset"])
+		}
+	}
+
+	return ""
+}
+
+// Returns the charset declared in the Content-Type HTTP header.
+func (p *Parser) headersCharset() string {
+	_, params, err := mime.ParseMediaType(p.Headers.Get("Content-Type"))
+	if err != nil {
+		return ""
+	}
+
+	return normalizeCharset(params["charset"])
+}
+
+// Returns the charset lowercased and without quotes, using "utf-8" for its common "utf8" alias.
+func normalizeCharset(c string) string {
+	c = strings.ToLower(strings.Trim(strings.TrimSpace(c), `"'`))
+	if c == "utf8" {
+		return "utf-8"
+	}
+
+	return c
+}
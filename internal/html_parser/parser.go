@@ -3,6 +3,7 @@ package html_parser
 import (
 	"bytes"
 	"errors"
+	"mime"
 	"net/http"
 	"net/url"
 	"strings"
@@ -18,11 +19,22 @@ import (
 type Parser struct {
 	sanitizer *bluemonday.Policy
 	doc       *html.Node
+	body      []byte
 	ParsedURL *url.URL
 	Headers   *http.Header
 }
 
 func newParser(url *url.URL, headers *http.Header, body []byte) (*Parser, error) {
+	// PDF documents are not parsed as HTML, the parser only needs the raw body.
+	if mediaType, _, _ := mime.ParseMediaType(headers.Get("Content-Type")); mediaType == PDFMediaType {
+		return &Parser{
+			sanitizer: bluemonday.StrictPolicy(),
+			body:      body,
+			ParsedURL: url,
+			Headers:   headers,
+		}, nil
+	}
+
 	utf8Body, err := charset.NewReader(bytes.NewReader(body), headers.Get("Content-Type"))
 	if err != nil {
 		return nil, err
@@ -36,6 +48,7 @@ func newParser(url *url.URL, headers *http.Header, body []byte) (*Parser, error)
 	return &Parser{
 		sanitizer: bluemonday.StrictPolicy(),
 		doc:       doc,
+		body:      body,
 		ParsedURL: url,
 		Headers:   headers,
 	}, nil
@@ -53,16 +66,11 @@ func (p *Parser) lang() string {
 	return lang
 }
 
-// Returns the document robots settings.
-// It will return the html meta robots content if it is not empty
-// otherwise it returns the content of the X-Robots-Tag header.
-func (p *Parser) robots() string {
-	robots := p.htmlMetaRobots()
-	if robots == "" {
-		return p.headersRobots()
-	}
-
-	return robots
+// Returns the document robots directives.
+// It returns the directives found in the X-Robots-Tag headers as well as the ones
+// defined in the robots meta tags.
+func (p *Parser) robotsDirectives() []models.RobotsDirective {
+	return append(p.headersRobotsDirectives(), p.htmlRobotsDirectives()...)
 }
 
 // Returns the document canonical settings.
@@ -77,6 +85,13 @@ func (p *Parser) canonical() string {
 	return canonical
 }
 
+// Returns all the canonical URLs declared in the document, first the ones in the
+// canonical HTML tags and then the ones in the HTTP headers. Unlike the canonical
+// function, the URLs keep their fragment so it can be reported.
+func (p *Parser) canonicals() []models.Canonical {
+	return append(p.htmlCanonicals(), p.headersCanonicals()...)
+}
+
 // Returns the document hreflangs.
 // It first looks into the hreflang HTML tags, if empty it returns the hreflangs
 // defined in the HTTP headers.
@@ -156,15 +171,24 @@ func (p *Parser) htmlMetaRefreshURL() string {
 	return ""
 }
 
-// The robots meta provides information to crawlers
+// Returns the name and content of all the meta tags with a name attribute.
 // ex. <meta name="robots" content="noindex, nofollow" />
-func (p *Parser) htmlMetaRobots() string {
-	robots, err := htmlquery.Query(p.doc, "//meta[@name=\"robots\"]/@content")
-	if err != nil || robots == nil {
-		return ""
+func (p *Parser) htmlMetaNames() [][2]string {
+	metas := [][2]string{}
+
+	nodes, err := htmlquery.QueryAll(p.doc, "//meta[@name and @content]")
+	if err != nil {
+		return metas
+	}
+
+	for _, n := range nodes {
+		metas = append(metas, [2]string{
+			htmlquery.SelectAttr(n, "name"),
+			htmlquery.SelectAttr(n, "content"),
+		})
 	}
 
-	return htmlquery.SelectAttr(robots, "content")
+	return metas
 }
 
 // H1 heading title
@@ -189,6 +213,44 @@ func (p *Parser) htmlH2() string {
 	return strings.TrimSpace(p.sanitizer.Sanitize(htmlquery.InnerText(h2)))
 }
 
+// Returns the h1 to h6 headings of the node in document order.
+// A heading skips levels if its level is more than one level deeper than the previous heading's,
+// in which case the SkipsLevel field is set. The first heading follows an implied h1, so pages
+// starting with an h2 heading are valid but pages starting with an h3 to h6 heading skip levels.
+// ex. <h1>Title</h1><h3>Subtitle</h3>, where the h3 heading skips the h2 level.
+func (p *Parser) htmlHeadings(n *html.Node) []models.Heading {
+	headings := []models.Heading{}
+	current := 1
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+
+			tag := strings.ToLower(c.Data)
+			if len(tag) == 2 && tag[0] == 'h' && tag[1] >= '1' && tag[1] <= '6' {
+				level := int(tag[1] - '0')
+				headings = append(headings, models.Heading{
+					Level:      level,
+					Text:       strings.TrimSpace(p.sanitizer.Sanitize(htmlquery.InnerText(c))),
+					SkipsLevel: level > current+1,
+				})
+				current = level
+
+				continue
+			}
+
+			walk(c)
+		}
+	}
+
+	walk(n)
+
+	return headings
+}
+
 // Canonical link defines the main version for duplicate and similar pages
 // ex. <link rel="canonical" href="http://example.com/canonical/" />
 func (p *Parser) htmlCanonical() string {
@@ -206,6 +268,26 @@ func (p *Parser) htmlCanonical() string {
 	return cu.String()
 }
 
+// Returns the URLs of all the canonical link tags in the document.
+func (p *Parser) htmlCanonicals() []models.Canonical {
+	canonicals := []models.Canonical{}
+	nodes, err := htmlquery.QueryAll(p.doc, "//link[@rel=\"canonical\"]")
+	if err != nil {
+		return canonicals
+	}
+
+	for _, n := range nodes {
+		u, err := p.canonicalURL(htmlquery.SelectAttr(n, "href"))
+		if err != nil {
+			continue
+		}
+
+		canonicals = append(canonicals, models.Canonical{URL: u, Source: models.CanonicalSourceHTML})
+	}
+
+	return canonicals
+}
+
 // The a tags contain links to other pages we may want to crawl
 // ex. <a href="https://example.com/link1">link1</a>
 func (p *Parser) htmlLinks() []models.Link {
@@ -254,6 +336,50 @@ func (p *Parser) htmlHreflang() []models.Hreflang {
 	return hreflangs
 }
 
+// Extract the pagination, AMP and mobile alternate link relations so we can send them to the crawler.
+// Alternate links are only considered mobile alternates if they have a media attribute and no hreflang.
+// ex. <link rel="next" href="https://example.com/page/2" />
+func (p *Parser) htmlLinkRelations() []models.LinkRelation {
+	relations := []models.LinkRelation{}
+	nodes, err := htmlquery.QueryAll(p.doc, "//link[@rel and @href]")
+	if err != nil {
+		return relations
+	}
+
+	for _, n := range nodes {
+		media := strings.TrimSpace(htmlquery.SelectAttr(n, "media"))
+		for _, rel := range strings.Fields(strings.ToLower(htmlquery.SelectAttr(n, "rel"))) {
+			if rel == "previous" {
+				rel = models.RelPrev
+			}
+
+			switch rel {
+			case models.RelNext, models.RelPrev, models.RelAmpHTML:
+				media = ""
+			case models.RelAlternate:
+				if media == "" || htmlquery.ExistsAttr(n, "hreflang") {
+					continue
+				}
+			default:
+				continue
+			}
+
+			u, err := p.absoluteURL(htmlquery.SelectAttr(n, "href"))
+			if err != nil {
+				continue
+			}
+
+			relations = append(relations, models.LinkRelation{
+				Rel:   rel,
+				URL:   u.String(),
+				Media: media,
+			})
+		}
+	}
+
+	return relations
+}
+
 // Extract images to check alt text and crawl src and srcset urls
 // ex. <img src="logo.jpg" srcset="/files/16870/new-york-skyline-wide.jpg 3724w">
 func (p *Parser) htmlImages() []models.Image {
@@ -270,11 +396,8 @@ func (p *Parser) htmlImages() []models.Image {
 			continue
 		}
 
-		alt := htmlquery.SelectAttr(n, "alt")
-		i := models.Image{
-			URL: url.String(),
-			Alt: alt,
-		}
+		i := newImage(n)
+		i.URL = url.String()
 		images = append(images, i)
 
 		imageSet := p.parseSrcSet(htmlquery.SelectAttr(n, "srcset"))
@@ -284,17 +407,27 @@ func (p *Parser) htmlImages() []models.Image {
 				continue
 			}
 
-			i := models.Image{
-				URL: url.String(),
-				Alt: alt,
-			}
-			images = append(images, i)
+			si := i
+			si.URL = url.String()
+			images = append(images, si)
 		}
 	}
 
 	return images
 }
 
+// Returns an Image with the attributes of the img node but without URL.
+// The width and height are truncated to the maximum length stored in the database.
+// ex. <img src="/img.png" alt="Image" width="100" height="50" srcset="/img-2x.png 2x">
+func newImage(n *html.Node) models.Image {
+	return models.Image{
+		Alt:    htmlquery.SelectAttr(n, "alt"),
+		Width:  truncate(strings.TrimSpace(htmlquery.SelectAttr(n, "width")), maxImageDimensionLength),
+		Height: truncate(strings.TrimSpace(htmlquery.SelectAttr(n, "height")), maxImageDimensionLength),
+		SrcSet: strings.TrimSpace(htmlquery.SelectAttr(n, "srcset")) != "",
+	}
+}
+
 // Extract iframe URLs
 // ex. <iframe height="500" width="500" src="http://example.com"></iframe>
 func (p *Parser) htmlIframes() []string {
@@ -336,7 +469,8 @@ func (p *Parser) htmlPictures() []models.Image {
 			continue
 		}
 
-		alt := htmlquery.SelectAttr(images[0], "alt")
+		img := newImage(images[0])
+		img.SrcSet = true
 		sources := htmlquery.Find(n, "//source")
 		for _, s := range sources {
 			imageSet := p.parseSrcSet(htmlquery.SelectAttr(s, "srcset"))
@@ -346,10 +480,8 @@ func (p *Parser) htmlPictures() []models.Image {
 					continue
 				}
 
-				i := models.Image{
-					URL: url.String(),
-					Alt: alt,
-				}
+				i := img
+				i.URL = url.String()
 				pictures = append(pictures, i)
 			}
 		}
@@ -473,18 +605,77 @@ func (p *Parser) htmlBodyNode() *html.Node {
 	return body
 }
 
+// Returns the values of the security related HTTP headers. Headers sent more than once
+// are joined with a comma, except for the Content-Security-Policy headers which are
+// joined with a semicolon so the directives of all policies can be read together.
+func (p *Parser) headersSecurity() models.SecurityHeaders {
+	values := func(name, sep string) string {
+		return strings.TrimSpace(strings.Join(p.Headers.Values(name), sep))
+	}
+
+	return models.SecurityHeaders{
+		StrictTransportSecurity: values("Strict-Transport-Security", ","),
+		ContentSecurityPolicy:   values("Content-Security-Policy", ";"),
+		XFrameOptions:           values("X-Frame-Options", ","),
+		XContentTypeOptions:     values("X-Content-Type-Options", ","),
+		ReferrerPolicy:          values("Referrer-Policy", ","),
+		PermissionsPolicy:       values("Permissions-Policy", ","),
+	}
+}
+
 // Parse hreflang links from the HTTP header
 func (p *Parser) headersCanonical() string {
-	linkHeaderElements := strings.Split(p.Headers.Get("Link"), ",")
+	for _, h := range p.headersCanonicalURLs() {
+		u, err := p.absoluteURL(h)
+		if err == nil {
+			return u.String()
+		}
+	}
+
+	return ""
+}
+
+// Returns all the canonical URLs defined in the Link HTTP headers.
+func (p *Parser) headersCanonicals() []models.Canonical {
+	canonicals := []models.Canonical{}
+	for _, h := range p.headersCanonicalURLs() {
+		u, err := p.canonicalURL(h)
+		if err != nil {
+			continue
+		}
+
+		canonicals = append(canonicals, models.Canonical{URL: u, Source: models.CanonicalSourceHeader})
+	}
+
+	return canonicals
+}
+
+// Returns the raw URLs of the Link HTTP headers with the canonical relation.
+// ex. Link: <https://example.com/page>; rel="canonical"
+func (p *Parser) headersCanonicalURLs() []string {
+	urls := []string{}
+	linkHeaderElements := strings.Split(strings.Join(p.Headers.Values("Link"), ","), ",")
 	for _, lh := range linkHeaderElements {
 		attr := strings.Split(lh, ";")
-		if len(attr) == 2 && strings.Contains(attr[1], `rel="canonical"`) {
-			url := strings.TrimSpace(attr[0])
-			return url[1 : len(url)-1]
+		if len(attr) < 2 {
+			continue
+		}
+
+		isCanonical := false
+		for _, a := range attr[1:] {
+			a = strings.ToLower(strings.ReplaceAll(a, " ", ""))
+			if a == `rel="canonical"` || a == "rel=canonical" {
+				isCanonical = true
+			}
+		}
+
+		url := strings.TrimSpace(attr[0])
+		if isCanonical && len(url) > 2 && url[0] == '<' && url[len(url)-1] == '>' {
+			urls = append(urls, url[1:len(url)-1])
 		}
 	}
 
-	return ""
+	return urls
 }
 
 // Parse hreflang links from the HTTP header
@@ -534,11 +725,6 @@ func (p *Parser) headersLang() string {
 	return ""
 }
 
-// Returns the contents of the X-Robots-Tag header.
-func (p *Parser) headersRobots() string {
-	return p.Headers.Get("X-Robots-Tag")
-}
-
 // Return the contents of the HTTP Location header.
 func (p *Parser) headersLocation() string {
 	l, err := p.absoluteURL(p.Headers.Get("Location"))
@@ -579,21 +765,100 @@ func (p *Parser) newLink(n *html.Node) (models.Link, error) {
 	}
 
 	rel := strings.TrimSpace(htmlquery.SelectAttr(n, "rel"))
+	text := strings.TrimSpace(htmlquery.InnerText(n))
+
+	images := htmlquery.Find(n, ".//img")
+	if text == "" {
+		alts := []string{}
+		for _, i := range images {
+			if alt := strings.TrimSpace(htmlquery.SelectAttr(i, "alt")); alt != "" {
+				alts = append(alts, alt)
+			}
+		}
+		text = strings.Join(alts, " ")
+	}
+
+	target := strings.ToLower(strings.TrimSpace(htmlquery.SelectAttr(n, "target")))
+	lrel := strings.ToLower(rel)
 
 	l := models.Link{
-		URL:       u.String(),
-		ParsedURL: u,
-		Rel:       rel,
-		Text:      p.sanitizer.Sanitize(strings.TrimSpace(htmlquery.InnerText(n))),
-		External:  u.Host != p.ParsedURL.Host,
-		NoFollow:  strings.Contains(rel, "nofollow"),
-		Sponsored: strings.Contains(rel, "sponsored"),
-		UGC:       strings.Contains(rel, "ugc"),
+		URL:           u.String(),
+		ParsedURL:     u,
+		Rel:           rel,
+		Text:          p.sanitizer.Sanitize(text),
+		External:      u.Host != p.ParsedURL.Host,
+		NoFollow:      strings.Contains(rel, "nofollow"),
+		Sponsored:     strings.Contains(rel, "sponsored"),
+		UGC:           strings.Contains(rel, "ugc"),
+		Position:      linkPosition(n),
+		Title:         p.sanitizer.Sanitize(strings.TrimSpace(htmlquery.SelectAttr(n, "title"))),
+		BlankNoOpener: target == "_blank" && !strings.Contains(lrel, "noopener") && !strings.Contains(lrel, "noreferrer"),
+		Image:         len(images) > 0,
 	}
 
 	return l, nil
 }
 
+// Layout elements and ARIA landmark roles used to find the position of a link.
+var linkPositionElements = map[string]string{
+	"nav":    models.LinkPositionNav,
+	"header": models.LinkPositionHeader,
+	"footer": models.LinkPositionFooter,
+	"aside":  models.LinkPositionAside,
+}
+
+var linkPositionRoles = map[string]string{
+	"navigation":    models.LinkPositionNav,
+	"banner":        models.LinkPositionHeader,
+	"contentinfo":   models.LinkPositionFooter,
+	"complementary": models.LinkPositionAside,
+}
+
+// Returns the position of the link in the page layout, which is the closest nav, header,
+// footer or aside ancestor element, or the equivalent landmark role. Links that are not
+// inside any of these elements are considered part of the main content.
+func linkPosition(n *html.Node) string {
+	for a := n.Parent; a != nil; a = a.Parent {
+		if a.Type != html.ElementNode {
+			continue
+		}
+
+		if a.Data == "main" {
+			return models.LinkPositionMain
+		}
+
+		if p, ok := linkPositionElements[a.Data]; ok {
+			return p
+		}
+
+		if p, ok := linkPositionRoles[strings.ToLower(htmlquery.SelectAttr(a, "role"))]; ok {
+			return p
+		}
+	}
+
+	return models.LinkPositionMain
+}
+
+// Returns the absolute canonical URL keeping its fragment, so canonicals with
+// fragments can be told apart. Only the http and https schemes are allowed.
+func (p *Parser) canonicalURL(s string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return "", err
+	}
+
+	a := p.ParsedURL.ResolveReference(u)
+	if a.Path == "" {
+		a.Path = "/"
+	}
+
+	if a.Scheme != "http" && a.Scheme != "https" {
+		return "", errors.New("Protocol not supported")
+	}
+
+	return a.String(), nil
+}
+
 // Return an absolute URL removing the URL fragment
 func (p *Parser) absoluteURL(s string) (*url.URL, error) {
 	u, err := url.Parse(strings.TrimSpace(s))
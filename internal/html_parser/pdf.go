@@ -0,0 +1,721 @@
+package html_parser
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/hex"
+	"html"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/stjudewashere/seonaut/internal/keywords"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// PDFMediaType is the media type of the PDF documents parsed by the html_parser.
+const PDFMediaType = "application/pdf"
+
+// The PDF documents are not rendered, their properties are extracted from the document objects,
+// which are indexed by object number and generation, including the objects compressed in object
+// streams. This is enough to get the document metadata, the links and an approximate word count
+// of the text shown in the pages.
+var (
+	pdfObjRegex      = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+	pdfRefRegex      = regexp.MustCompile(`(\d+)\s+(\d+)\s+R\b`)
+	pdfLengthRegex   = regexp.MustCompile(`/Length\s+(\d+)\b(\s+\d+\s+R)?`)
+	pdfInfoRegex     = regexp.MustCompile(`/Info\s+(\d+)\s+(\d+)\s+R`)
+	pdfRootRegex     = regexp.MustCompile(`/Root\s+(\d+)\s+(\d+)\s+R`)
+	pdfPagesRegex    = regexp.MustCompile(`/Pages\s+(\d+)\s+(\d+)\s+R`)
+	pdfKidsRegex     = regexp.MustCompile(`/Kids\s*\[([^\]]*)\]`)
+	pdfContentsRegex = regexp.MustCompile(`/Contents\s*(\[[^\]]*\]|\d+\s+\d+\s+R)`)
+	pdfObjStmRegex   = regexp.MustCompile(`/Type\s*/ObjStm\b`)
+	pdfFirstRegex    = regexp.MustCompile(`/First\s+(\d+)`)
+	pdfNRegex        = regexp.MustCompile(`/N\s+(\d+)`)
+	pdfCatalogRegex  = regexp.MustCompile(`/Type\s*/Catalog\b`)
+	pdfTitleRegex    = regexp.MustCompile(`/Title\s*([(<])`)
+	pdfLangRegex     = regexp.MustCompile(`/Lang\s*([(<])`)
+	pdfURIRegex      = regexp.MustCompile(`/URI\s*([(<])`)
+	pdfPageRegex     = regexp.MustCompile(`/Type\s*/Page\b`)
+	pdfXMPTitleRegex = regexp.MustCompile(`(?s)<dc:title>.*?<rdf:li[^>]*>(.*?)</rdf:li>`)
+	pdfBfcharRegex   = regexp.MustCompile(`(?s)beginbfchar(.*?)endbfchar`)
+	pdfBfrangeRegex  = regexp.MustCompile(`(?s)beginbfrange(.*?)endbfrange`)
+	pdfHexRegex      = regexp.MustCompile(`<([0-9A-Fa-f\s]*)>`)
+)
+
+const (
+	// Maximum size of all the decompressed streams of a PDF document.
+	maxPDFStreamsSize = 5 * maxBodySize
+
+	// Maximum depth of the page tree that is walked to find the document pages.
+	maxPDFPageTreeDepth = 32
+)
+
+// pdfObject is an indirect object of a PDF document.
+type pdfObject struct {
+	dict   []byte // Object data up to the stream keyword
+	stream []byte // Decompressed stream data, nil if the object has no stream or it can't be decoded
+}
+
+// pdfDocument contains the raw PDF data along with its objects.
+type pdfDocument struct {
+	data    []byte
+	objects map[string]*pdfObject // Objects by object number and generation, ex. "12 0"
+	order   []string              // Object keys in the order they were found
+	cmap    map[string]string     // Character codes to unicode text from the ToUnicode CMaps
+	codeLen int                   // Length in bytes of the character codes in the CMaps
+}
+
+// Parses the PDF document in the response body, setting the page report title, language,
+// word count and links, as well as the number of pages of the document.
+func (p *Parser) pdf(pageReport *models.PageReport) {
+	d := newPDFDocument(p.body)
+
+	pageReport.Title = d.title()
+	pageReport.Lang = d.lang()
+	if pageReport.Lang == "" {
+		pageReport.Lang = p.headersLang()
+	}
+	pageReport.ValidLang = pageReport.Lang == "" || langIsValid(pageReport.Lang)
+	pageReport.PDF = models.PDF{Pages: len(d.pages())}
+	pageReport.Canonical = p.headersCanonical()
+	pageReport.Canonicals = p.headersCanonicals()
+
+	words := keywords.Words(d.text())
+	pageReport.Words = len(words)
+	pageReport.Terms = keywords.Count(words, pageReport.Lang)
+	pageReport.Keywords = keywords.Extract(pageReport.Terms, pageReport.Title, "")
+
+	seen := map[string]bool{}
+	for _, uri := range d.uris() {
+		u, err := p.absoluteURL(uri)
+		if err != nil || seen[u.String()] {
+			continue
+		}
+		seen[u.String()] = true
+
+		l := models.Link{
+			URL:       u.String(),
+			ParsedURL: u,
+			External:  u.Host != p.ParsedURL.Host,
+			Position:  models.LinkPositionMain,
+		}
+
+		if l.External {
+			pageReport.ExternalLinks = append(pageReport.ExternalLinks, l)
+		} else {
+			pageReport.Links = append(pageReport.Links, l)
+		}
+	}
+}
+
+// Returns a pdfDocument with the objects of the PDF data. Objects defined more than once, as in
+// documents with incremental updates, are kept once with their last definition. The streams are
+// decompressed, streams compressed with filters other than FlateDecode are ignored.
+func newPDFDocument(data []byte) *pdfDocument {
+	d := &pdfDocument{data: data, objects: map[string]*pdfObject{}, cmap: map[string]string{}}
+
+	total := 0
+	pos := 0
+	for _, m := range pdfObjRegex.FindAllSubmatchIndex(data, -1) {
+		if m[0] < pos || (m[0] > 0 && !isPDFWhitespace(data[m[0]-1])) {
+			continue
+		}
+
+		o, end := parsePDFObject(data, m[1])
+		pos = end
+
+		if o.stream != nil {
+			total += len(o.stream)
+			if total > maxPDFStreamsSize {
+				o.stream = nil
+			}
+		}
+
+		d.setObject(pdfRef(data[m[2]:m[3]], data[m[4]:m[5]]), o, true)
+	}
+
+	// Objects in object streams don't replace the objects defined in the document body.
+	for _, k := range append([]string{}, d.order...) {
+		o := d.objects[k]
+		if o.stream != nil && pdfObjStmRegex.Match(o.dict) {
+			d.addObjectStream(o)
+		}
+	}
+
+	for _, k := range d.order {
+		if s := d.objects[k].stream; bytes.Contains(s, []byte("begincmap")) {
+			d.addCMap(s)
+		}
+	}
+
+	return d
+}
+
+// Parses the object data starting right after the "obj" keyword. It returns the object
+// and the position of the data after the end of the object.
+func parsePDFObject(data []byte, start int) (*pdfObject, int) {
+	o := &pdfObject{}
+
+	endobj := bytes.Index(data[start:], []byte("endobj"))
+	stream := bytes.Index(data[start:], []byte("stream"))
+	if stream < 0 || (endobj >= 0 && stream > endobj) {
+		if endobj < 0 {
+			o.dict = data[start:]
+			return o, len(data)
+		}
+
+		o.dict = data[start : start+endobj]
+		return o, start + endobj + len("endobj")
+	}
+
+	o.dict = data[start : start+stream]
+	dataStart := start + stream + len("stream")
+	if dataStart < len(data) && data[dataStart] == '\r' {
+		dataStart++
+	}
+	if dataStart < len(data) && data[dataStart] == '\n' {
+		dataStart++
+	}
+
+	// Use the stream length if it is a direct value, otherwise look for the endstream keyword.
+	dataEnd := -1
+	if m := pdfLengthRegex.FindSubmatch(o.dict); m != nil && m[2] == nil {
+		l, err := strconv.Atoi(string(m[1]))
+		if err == nil && l <= len(data)-dataStart {
+			rest := bytes.TrimLeft(data[dataStart+l:], "\r\n \t")
+			if bytes.HasPrefix(rest, []byte("endstream")) {
+				dataEnd = dataStart + l
+			}
+		}
+	}
+
+	if dataEnd < 0 {
+		e := bytes.Index(data[dataStart:], []byte("endstream"))
+		if e < 0 {
+			return o, len(data)
+		}
+		dataEnd = dataStart + e
+	}
+
+	o.stream = decodePDFStream(o.dict, data[dataStart:dataEnd])
+
+	end := dataEnd
+	if e := bytes.Index(data[dataEnd:], []byte("endobj")); e >= 0 {
+		end = dataEnd + e + len("endobj")
+	}
+
+	return o, end
+}
+
+// Returns the decompressed stream data. Streams compressed with filters other
+// than FlateDecode can't be decoded and nil is returned.
+func decodePDFStream(dict, raw []byte) []byte {
+	switch {
+	case bytes.Contains(dict, []byte("/FlateDecode")):
+		r, err := zlib.NewReader(bytes.NewReader(raw))
+		if err != nil {
+			return nil
+		}
+		defer r.Close()
+
+		s, _ := ioutil.ReadAll(io.LimitReader(r, maxBodySize))
+
+		return s
+	case !bytes.Contains(dict, []byte("/Filter")):
+		return raw
+	}
+
+	return nil
+}
+
+// Adds the objects compressed in an object stream. The stream starts with pairs of object
+// numbers and offsets, and the objects data starts at the offset set in the First entry.
+func (d *pdfDocument) addObjectStream(o *pdfObject) {
+	mn := pdfNRegex.FindSubmatch(o.dict)
+	mf := pdfFirstRegex.FindSubmatch(o.dict)
+	if mn == nil || mf == nil {
+		return
+	}
+
+	n, _ := strconv.Atoi(string(mn[1]))
+	first, _ := strconv.Atoi(string(mf[1]))
+	if first > len(o.stream) {
+		return
+	}
+
+	// The offsets are relative to First and are compared with the remaining stream length,
+	// so that invalid offsets can't overflow or point outside of the stream.
+	header := strings.Fields(string(o.stream[:first]))
+	for i := 0; i < n && 2*i+1 < len(header); i++ {
+		start, err := strconv.Atoi(header[2*i+1])
+		if err != nil || start < 0 || start > len(o.stream)-first {
+			continue
+		}
+
+		end := len(o.stream)
+		if 2*i+3 < len(header) {
+			if next, err := strconv.Atoi(header[2*i+3]); err == nil && next >= start && next <= len(o.stream)-first {
+				end = first + next
+			}
+		}
+
+		d.setObject(pdfRef([]byte(header[2*i]), []byte("0")), &pdfObject{dict: o.stream[first+start : end]}, false)
+	}
+}
+
+// Sets the object with the key, replacing any existing object with the same key if replace is true.
+func (d *pdfDocument) setObject(k string, o *pdfObject, replace bool) {
+	if _, ok := d.objects[k]; !ok {
+		d.order = append(d.order, k)
+	} else if !replace {
+		return
+	}
+
+	d.objects[k] = o
+}
+
+// Returns the object referenced by the last match of the regex in the document data.
+// The regex must have two groups with the object number and generation.
+func (d *pdfDocument) trailerObject(refRegex *regexp.Regexp) *pdfObject {
+	m := refRegex.FindAllSubmatch(d.data, -1)
+	if len(m) == 0 {
+		return nil
+	}
+
+	return d.objects[pdfRef(m[len(m)-1][1], m[len(m)-1][2])]
+}
+
+// Returns the document catalog, which is the root object of the document.
+func (d *pdfDocument) catalog() *pdfObject {
+	if o := d.trailerObject(pdfRootRegex); o != nil {
+		return o
+	}
+
+	for _, k := range d.order {
+		if pdfCatalogRegex.Match(d.objects[k].dict) {
+			return d.objects[k]
+		}
+	}
+
+	return nil
+}
+
+// Returns the document title from the document information dictionary, or from
+// the XMP metadata if it is not set.
+func (d *pdfDocument) title() string {
+	if o := d.trailerObject(pdfInfoRegex); o != nil {
+		if t := pdfStringValue(o.dict, pdfTitleRegex); t != "" {
+			return t
+		}
+	}
+
+	for _, k := range d.order {
+		if m := pdfXMPTitleRegex.FindSubmatch(d.objects[k].stream); m != nil {
+			return strings.TrimSpace(html.UnescapeString(string(m[1])))
+		}
+	}
+
+	return ""
+}
+
+// Returns the natural language of the document specified in the document catalog.
+func (d *pdfDocument) lang() string {
+	if c := d.catalog(); c != nil {
+		return pdfStringValue(c.dict, pdfLangRegex)
+	}
+
+	return ""
+}
+
+// Returns the page objects of the document in page order, walking the page tree from the
+// document catalog. If the page tree can't be walked the page objects are returned in the
+// order they were found.
+func (d *pdfDocument) pages() []*pdfObject {
+	pages := []*pdfObject{}
+	if c := d.catalog(); c != nil {
+		if m := pdfPagesRegex.FindSubmatch(c.dict); m != nil {
+			d.walkPageTree(pdfRef(m[1], m[2]), 0, map[string]bool{}, &pages)
+		}
+	}
+
+	if len(pages) > 0 {
+		return pages
+	}
+
+	for _, k := range d.order {
+		if pdfPageRegex.Match(d.objects[k].dict) {
+			pages = append(pages, d.objects[k])
+		}
+	}
+
+	return pages
+}
+
+// Adds the page objects of the page tree node with the key to the pages slice.
+func (d *pdfDocument) walkPageTree(k string, depth int, visited map[string]bool, pages *[]*pdfObject) {
+	o, ok := d.objects[k]
+	if !ok || visited[k] || depth > maxPDFPageTreeDepth {
+		return
+	}
+	visited[k] = true
+
+	if pdfPageRegex.Match(o.dict) {
+		*pages = append(*pages, o)
+		return
+	}
+
+	if m := pdfKidsRegex.FindSubmatch(o.dict); m != nil {
+		for _, r := range pdfRefRegex.FindAllSubmatch(m[1], -1) {
+			d.walkPageTree(pdfRef(r[1], r[2]), depth+1, visited, pages)
+		}
+	}
+}
+
+// Returns the URIs of the link annotations in the document.
+func (d *pdfDocument) uris() []string {
+	uris := []string{}
+	for _, k := range d.order {
+		s := d.objects[k].dict
+		for _, m := range pdfURIRegex.FindAllSubmatchIndex(s, -1) {
+			v, _ := pdfString(s[m[2]:])
+			if u := strings.TrimSpace(pdfTextString(v)); u != "" {
+				uris = append(uris, u)
+			}
+		}
+	}
+
+	return uris
+}
+
+// Returns the text shown in the content streams of the document pages.
+func (d *pdfDocument) text() string {
+	var buf strings.Builder
+	for _, p := range d.pages() {
+		m := pdfContentsRegex.FindSubmatch(p.dict)
+		if m == nil {
+			continue
+		}
+
+		for _, r := range pdfRefRegex.FindAllSubmatch(m[1], -1) {
+			o, ok := d.objects[pdfRef(r[1], r[2])]
+			if !ok || o.stream == nil {
+				continue
+			}
+
+			buf.WriteString(d.contentText(o.stream))
+			buf.WriteString("\n")
+		}
+	}
+
+	return buf.String()
+}
+
+// Returns the key of the object with the object number and generation.
+func pdfRef(num, gen []byte) string {
+	n, _ := strconv.Atoi(string(num))
+	g, _ := strconv.Atoi(string(gen))
+
+	return strconv.Itoa(n) + " " + strconv.Itoa(g)
+}
+
+// Returns true if the byte is a PDF white-space character.
+func isPDFWhitespace(c byte) bool {
+	return c == ' ' || c == '\n' || c == '\r' || c == '\t' || c == '\f' || c == 0
+}
+
+// Returns the text shown by the text operators of a content stream. A space is added
+// when the text position is moved and for large spacings in TJ arrays.
+func (d *pdfDocument) contentText(s []byte) string {
+	var buf strings.Builder
+	inText := false
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '%':
+			for i < len(s) && s[i] != '\n' && s[i] != '\r' {
+				i++
+			}
+		case c == '(' || (c == '<' && i+1 < len(s) && s[i+1] != '<'):
+			v, n := pdfString(s[i:])
+			if inText {
+				buf.WriteString(d.decode(v))
+			}
+			i += n - 1
+		case c == '-' || c == '.' || (c >= '0' && c <= '9'):
+			j := i + 1
+			for j < len(s) && (s[j] == '.' || (s[j] >= '0' && s[j] <= '9')) {
+				j++
+			}
+			if f, err := strconv.ParseFloat(string(s[i:j]), 64); err == nil && inText && f <= -200 {
+				buf.WriteString(" ")
+			}
+			i = j - 1
+		case (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || c == '\'' || c == '"':
+			j := i + 1
+			for j < len(s) && ((s[j] >= 'A' && s[j] <= 'Z') || (s[j] >= 'a' && s[j] <= 'z') || s[j] == '*') {
+				j++
+			}
+
+			switch string(s[i:j]) {
+			case "BT":
+				inText = true
+			case "ET":
+				inText = false
+				buf.WriteString("\n")
+			case "Td", "TD", "T*", "Tm", "'", "\"":
+				buf.WriteString(" ")
+			case "ID":
+				end := bytes.Index(s[j:], []byte("EI"))
+				if end < 0 {
+					return buf.String()
+				}
+				j += end + 2
+			}
+			i = j - 1
+		}
+	}
+
+	return buf.String()
+}
+
+// Adds the character mappings of a ToUnicode CMap to the document's CMap.
+func (d *pdfDocument) addCMap(s []byte) {
+	for _, m := range pdfBfcharRegex.FindAllSubmatch(s, -1) {
+		h := pdfHexRegex.FindAllSubmatch(m[1], -1)
+		for i := 0; i+1 < len(h); i += 2 {
+			d.addCode(pdfHex(h[i][1]), utf16BE(pdfHex(h[i+1][1])))
+		}
+	}
+
+	for _, m := range pdfBfrangeRegex.FindAllSubmatch(s, -1) {
+		for _, line := range bytes.Split(m[1], []byte("\n")) {
+			h := pdfHexRegex.FindAllSubmatch(line, -1)
+			if len(h) < 3 {
+				continue
+			}
+
+			lo, hi := pdfHex(h[0][1]), pdfHex(h[1][1])
+			if len(lo) == 0 || len(lo) != len(hi) || len(lo) > 2 {
+				continue
+			}
+
+			from, to := codeValue(lo), codeValue(hi)
+			if to < from || to-from > 0xFFFF {
+				continue
+			}
+
+			isArray := bytes.Contains(line, []byte("["))
+			dst := pdfHex(h[2][1])
+			for c := from; c <= to; c++ {
+				code := codeBytes(c, len(lo))
+				if isArray {
+					if k := 2 + c - from; k < len(h) {
+						d.addCode(code, utf16BE(pdfHex(h[k][1])))
+					}
+					continue
+				}
+
+				v := append([]byte{}, dst...)
+				if len(v) > 0 {
+					v[len(v)-1] += byte(c - from)
+				}
+				d.addCode(code, utf16BE(v))
+			}
+		}
+	}
+}
+
+// Adds a character code mapping to the document's CMap.
+func (d *pdfDocument) addCode(code []byte, text string) {
+	if len(code) == 0 {
+		return
+	}
+
+	d.cmap[string(code)] = text
+	if len(code) > d.codeLen {
+		d.codeLen = len(code)
+	}
+}
+
+// Decodes the character codes of a string shown in a content stream using the
+// document's CMap. Codes without mapping are decoded as PDFDocEncoding characters.
+func (d *pdfDocument) decode(b []byte) string {
+	if d.codeLen == 0 {
+		return pdfTextString(b)
+	}
+
+	var buf strings.Builder
+	for i := 0; i < len(b); i += d.codeLen {
+		end := i + d.codeLen
+		if end > len(b) {
+			end = len(b)
+		}
+
+		if t, ok := d.cmap[string(b[i:end])]; ok {
+			buf.WriteString(t)
+		} else if t, ok := d.cmap[string(b[i:i+1])]; ok {
+			buf.WriteString(t)
+			i = i + 1 - d.codeLen
+		} else if d.codeLen == 1 {
+			buf.WriteString(pdfTextString(b[i:end]))
+		}
+	}
+
+	return buf.String()
+}
+
+// Returns the value of the first string after the key matched by the regex.
+func pdfStringValue(s []byte, keyRegex *regexp.Regexp) string {
+	m := keyRegex.FindSubmatchIndex(s)
+	if m == nil {
+		return ""
+	}
+
+	v, _ := pdfString(s[m[2]:])
+
+	return strings.TrimSpace(pdfTextString(v))
+}
+
+// Parses the literal or hexadecimal string at the start of s, returning its bytes
+// and the number of bytes of s that were read.
+func pdfString(s []byte) ([]byte, int) {
+	if len(s) == 0 {
+		return nil, 0
+	}
+
+	if s[0] == '<' {
+		end := bytes.IndexByte(s, '>')
+		if end < 0 {
+			return nil, len(s)
+		}
+
+		return pdfHex(s[1:end]), end + 1
+	}
+
+	var buf []byte
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '(':
+			depth++
+			if depth == 1 {
+				continue
+			}
+		case ')':
+			depth--
+			if depth == 0 {
+				return buf, i + 1
+			}
+		case '\\':
+			i++
+			if i >= len(s) {
+				return buf, i
+			}
+
+			switch e := s[i]; e {
+			case 'n':
+				buf = append(buf, '\n')
+			case 'r':
+				buf = append(buf, '\r')
+			case 't':
+				buf = append(buf, '\t')
+			case 'b':
+				buf = append(buf, '\b')
+			case 'f':
+				buf = append(buf, '\f')
+			case '\r', '\n':
+				if e == '\r' && i+1 < len(s) && s[i+1] == '\n' {
+					i++
+				}
+			default:
+				if e >= '0' && e <= '7' {
+					j := i
+					for j < len(s) && j < i+3 && s[j] >= '0' && s[j] <= '7' {
+						j++
+					}
+					v, _ := strconv.ParseUint(string(s[i:j]), 8, 8)
+					buf = append(buf, byte(v))
+					i = j - 1
+				} else {
+					buf = append(buf, e)
+				}
+			}
+			continue
+		}
+
+		buf = append(buf, c)
+	}
+
+	return buf, len(s)
+}
+
+// Returns the bytes of a hexadecimal string. A missing final digit is assumed to be 0.
+func pdfHex(s []byte) []byte {
+	h := strings.Join(strings.Fields(string(s)), "")
+	if len(h)%2 == 1 {
+		h += "0"
+	}
+
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
+
+// Decodes a PDF text string, which is either UTF-16BE with a byte order mark,
+// UTF-8 with a byte order mark, or PDFDocEncoding, which is handled as Latin-1.
+func pdfTextString(b []byte) string {
+	if len(b) >= 2 && b[0] == 0xFE && b[1] == 0xFF {
+		return utf16BE(b[2:])
+	}
+
+	if len(b) >= 3 && b[0] == 0xEF && b[1] == 0xBB && b[2] == 0xBF {
+		return string(b[3:])
+	}
+
+	r := make([]rune, len(b))
+	for i, c := range b {
+		r[i] = rune(c)
+	}
+
+	return string(r)
+}
+
+// Decodes UTF-16BE bytes.
+func utf16BE(b []byte) string {
+	u := make([]uint16, len(b)/2)
+	for i := range u {
+		u[i] = uint16(b[2*i])<<8 | uint16(b[2*i+1])
+	}
+
+	return string(utf16.Decode(u))
+}
+
+// Returns the numeric value of a character code.
+func codeValue(b []byte) int {
+	v := 0
+	for _, c := range b {
+		v = v<<8 | int(c)
+	}
+
+	return v
+}
+
+// Returns the bytes of a character code with the given length.
+func codeBytes(v, length int) []byte {
+	b := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+
+	return b
+}
@@ -0,0 +1,137 @@
+package html_parser
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/stjudewashere/seonaut/internal/keywords"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// Elements whose text is not visible in the page.
+var nonVisibleElements = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"noscript": true,
+	"template": true,
+	"svg":      true,
+}
+
+// Matches the end of a sentence or a block of text.
+var sentenceEndRegex = regexp.MustCompile(`[.!?;。！？…]+(\s|$)|\n`)
+
+// Vowels used to estimate the number of syllables of a word.
+const vowels = "aeiouyáéíóúàèìòùâêîôûäëïöüãõåæøœаеёиоуыэюя"
+
+// readabilityFormula holds the coefficients of a Flesch style reading ease formula:
+// base - sentenceWeight * (words / sentences) - syllableWeight * (syllables / words).
+type readabilityFormula struct {
+	base           float64
+	sentenceWeight float64
+	syllableWeight float64
+}
+
+// Flesch reading ease formulas adapted to each language.
+var readabilityFormulas = map[string]readabilityFormula{
+	"en": {206.835, 1.015, 84.6}, // Flesch
+	"es": {206.84, 1.02, 60},     // Fernández Huerta
+	"fr": {207, 1.015, 73.6},     // Kandel and Moles
+	"de": {180, 1, 58.5},         // Amstad
+	"it": {217, 1.3, 60},         // Flesch-Vacca
+	"nl": {206.835, 0.93, 77},    // Douma
+	"pt": {248.835, 1.015, 84.6}, // Martins et al.
+	"ru": {206.835, 1.3, 60.1},   // Oborneva
+}
+
+// Sets the text metrics of the PageReport: the text to HTML ratio, the number of sentences
+// and the average sentence length. It also sets the readability score if there is a formula
+// for the page's language.
+func (p *Parser) readability(pageReport *models.PageReport) {
+	body := p.htmlBodyNode()
+	if body == nil || pageReport.Size == 0 {
+		return
+	}
+
+	visible := strings.Join(strings.Fields(nodeText(body, nonVisibleElements)), " ")
+	pageReport.TextRatio = float64(len(visible)) * 100 / float64(pageReport.Size)
+
+	main := p.mainContentNode()
+	if main == nil {
+		return
+	}
+
+	words, sentences := 0, 0
+	syllables := 0
+	lang := readabilityLang(pageReport.Lang)
+	for _, s := range sentenceEndRegex.Split(nodeText(main, nonContentElements), -1) {
+		w := keywords.Words(s)
+		if len(w) == 0 {
+			continue
+		}
+
+		sentences++
+		words += len(w)
+		for _, word := range w {
+			syllables += countSyllables(word, lang)
+		}
+	}
+
+	if sentences == 0 {
+		return
+	}
+
+	pageReport.Sentences = sentences
+	pageReport.AvgSentenceLength = float64(words) / float64(sentences)
+
+	f, ok := readabilityFormulas[lang]
+	if !ok {
+		return
+	}
+
+	score := f.base - f.sentenceWeight*pageReport.AvgSentenceLength - f.syllableWeight*float64(syllables)/float64(words)
+	if score < 0 {
+		score = 0
+	}
+
+	if score > 100 {
+		score = 100
+	}
+
+	pageReport.Readability = score
+	pageReport.ValidReadability = true
+}
+
+// Returns the lowercased primary language subtag of the first language in the lang string.
+// ex. "en-US" returns "en"
+func readabilityLang(lang string) string {
+	lang = strings.Split(lang, ",")[0]
+	lang = strings.Split(lang, "-")[0]
+	lang = strings.Split(lang, "_")[0]
+
+	return strings.ToLower(strings.TrimSpace(lang))
+}
+
+// Estimates the number of syllables of a word by counting the groups of consecutive vowels.
+// In English and French a trailing silent "e" is not counted. All words have at least one syllable.
+func countSyllables(word, lang string) int {
+	count := 0
+	previousVowel := false
+	for _, r := range word {
+		isVowel := strings.ContainsRune(vowels, unicode.ToLower(r))
+		if isVowel && !previousVowel {
+			count++
+		}
+		previousVowel = isVowel
+	}
+
+	if (lang == "en" || lang == "fr") && count > 1 && strings.HasSuffix(word, "e") && !strings.HasSuffix(word, "le") {
+		count--
+	}
+
+	if count == 0 {
+		return 1
+	}
+
+	return count
+}
@@ -0,0 +1,217 @@
+package html_parser
+
+import (
+	"strings"
+	"time"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+const (
+	robotsSourceHTML   = "html"
+	robotsSourceHeader = "header"
+)
+
+// Known robots directives as documented by the main search engines.
+var robotsDirectives = map[string]bool{
+	"all":               true,
+	"index":             true,
+	"noindex":           true,
+	"follow":            true,
+	"nofollow":          true,
+	"none":              true,
+	"noarchive":         true,
+	"nocache":           true,
+	"nosnippet":         true,
+	"noimageindex":      true,
+	"notranslate":       true,
+	"indexifembedded":   true,
+	"max-snippet":       true,
+	"max-image-preview": true,
+	"max-video-preview": true,
+	"unavailable_after": true,
+	"noodp":             true,
+	"noydir":            true,
+}
+
+// Date formats accepted in the unavailable_after directive.
+var unavailableAfterLayouts = []string{
+	time.RFC3339,
+	time.RFC850,
+	time.RFC822,
+	time.RFC822Z,
+	time.RFC1123,
+	time.RFC1123Z,
+	"2006-01-02",
+	"2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006",
+}
+
+// EvaluateRobots sets the Robots, Noindex, Nofollow and RobotsConflict fields of the PageReport
+// using the robots directives that apply to the bot. Directives without a bot name apply to all
+// bots, while bot specific directives only apply if the bot name matches.
+// The most restrictive directives take precedence.
+func EvaluateRobots(pageReport *models.PageReport, bot string) {
+	bot = strings.ToLower(strings.TrimSpace(bot))
+
+	seen := make(map[string]bool)
+	values := make(map[string]string)
+	robots := []string{}
+	conflict := false
+
+	for _, d := range pageReport.RobotsDirectives {
+		if d.Bot != "" && d.Bot != bot {
+			continue
+		}
+
+		if v, ok := values[d.Name]; ok && v != d.Value {
+			conflict = true
+		}
+		values[d.Name] = d.Value
+
+		r := d.Name
+		if d.Value != "" {
+			r += ":" + d.Value
+		}
+
+		if !seen[r] {
+			robots = append(robots, r)
+			seen[r] = true
+		}
+	}
+
+	has := func(names ...string) bool {
+		for _, n := range names {
+			if _, ok := values[n]; ok {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	noindex := has("noindex", "none") || isUnavailable(values["unavailable_after"])
+	nofollow := has("nofollow", "none")
+
+	if (noindex && has("index", "all")) || (nofollow && has("follow", "all")) {
+		conflict = true
+	}
+
+	pageReport.Robots = strings.Join(robots, ", ")
+	pageReport.Noindex = noindex
+	pageReport.Nofollow = nofollow
+	pageReport.RobotsConflict = conflict
+}
+
+// Returns true if the date of an unavailable_after directive has already passed.
+// Dates that can't be parsed are ignored.
+func isUnavailable(date string) bool {
+	if date == "" {
+		return false
+	}
+
+	for _, l := range unavailableAfterLayouts {
+		t, err := time.Parse(l, date)
+		if err == nil {
+			return t.Before(time.Now())
+		}
+	}
+
+	return false
+}
+
+// Parses a comma separated list of robots directives. The directives can be
+// preceded by a bot name, ex. "googlebot: noindex, nofollow", in which case they
+// only apply to that bot. Directives without a bot name apply to the given bot.
+// It also returns false if any of the items is not a known directive.
+func parseRobotsDirectives(s, source, bot string) ([]models.RobotsDirective, bool) {
+	directives := []models.RobotsDirective{}
+	valid := true
+
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		name, value := splitRobotsDirective(item)
+		if !robotsDirectives[name] && value != "" && !strings.Contains(name, " ") {
+			// The item is preceded by a bot name.
+			bot = name
+			name, value = splitRobotsDirective(value)
+		}
+
+		if !robotsDirectives[name] {
+			// Dates in the unavailable_after directive may contain commas.
+			last := len(directives) - 1
+			if last >= 0 && directives[last].Name == "unavailable_after" {
+				directives[last].Value += ", " + item
+				continue
+			}
+
+			valid = false
+			continue
+		}
+
+		directives = append(directives, models.RobotsDirective{
+			Source: source,
+			Bot:    bot,
+			Name:   name,
+			Value:  value,
+		})
+	}
+
+	return directives, valid
+}
+
+// Splits a robots directive into its lowercase name and its value.
+func splitRobotsDirective(s string) (string, string) {
+	i := strings.Index(s, ":")
+	if i < 0 {
+		return strings.ToLower(strings.TrimSpace(s)), ""
+	}
+
+	return strings.ToLower(strings.TrimSpace(s[:i])), strings.TrimSpace(s[i+1:])
+}
+
+// Returns the robots directives in the meta tags. The robots meta tag applies to all bots,
+// ex. <meta name="robots" content="noindex" />, while the directives in meta tags named after
+// a bot only apply to that bot, ex. <meta name="googlebot" content="noindex" />.
+// Meta tags not named robots are considered only if all of its content are robots directives.
+func (p *Parser) htmlRobotsDirectives() []models.RobotsDirective {
+	directives := []models.RobotsDirective{}
+
+	for _, n := range p.htmlMetaNames() {
+		name := strings.ToLower(strings.TrimSpace(n[0]))
+		if name == "" || strings.Contains(name, " ") {
+			continue
+		}
+
+		bot := name
+		if name == "robots" {
+			bot = ""
+		}
+
+		d, valid := parseRobotsDirectives(n[1], robotsSourceHTML, bot)
+		if name != "robots" && !valid {
+			continue
+		}
+
+		directives = append(directives, d...)
+	}
+
+	return directives
+}
+
+// Returns the robots directives in all the X-Robots-Tag headers.
+// ex. X-Robots-Tag: googlebot: noindex, nofollow
+func (p *Parser) headersRobotsDirectives() []models.RobotsDirective {
+	directives := []models.RobotsDirective{}
+
+	for _, h := range p.Headers.Values("X-Robots-Tag") {
+		d, _ := parseRobotsDirectives(h, robotsSourceHeader, "")
+		directives = append(directives, d...)
+	}
+
+	return directives
+}
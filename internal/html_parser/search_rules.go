@@ -0,0 +1,110 @@
+package html_parser
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// SearchRule is a custom search rule with its regular expression compiled, so the expression
+// is compiled once per crawl instead of once per parsed page.
+type SearchRule struct {
+	models.SearchRule
+	regex *regexp.Regexp
+}
+
+// ValidateSearchRule returns an error if the search rule has no name or pattern, any of its options
+// is not supported or its regular expression can't be compiled.
+func ValidateSearchRule(r models.SearchRule) error {
+	if strings.TrimSpace(r.Name) == "" {
+		return errors.New("search rule name is empty")
+	}
+
+	if r.Pattern == "" {
+		return errors.New("search rule pattern is empty")
+	}
+
+	if r.Mode != models.SearchContains && r.Mode != models.SearchNotContains {
+		return errors.New("search rule mode not supported")
+	}
+
+	if r.Target != models.SearchHTML && r.Target != models.SearchText {
+		return errors.New("search rule target not supported")
+	}
+
+	_, err := compileSearchRule(r)
+
+	return err
+}
+
+// CompileSearchRules returns the search rules with their regular expressions compiled.
+// Search rules with patterns that can't be compiled are not included.
+func CompileSearchRules(rules []models.SearchRule) []*SearchRule {
+	compiled := []*SearchRule{}
+	for _, r := range rules {
+		c, err := compileSearchRule(r)
+		if err != nil {
+			continue
+		}
+
+		compiled = append(compiled, c)
+	}
+
+	return compiled
+}
+
+// Returns a SearchRule with its regular expression compiled if the rule is of regex type.
+func compileSearchRule(r models.SearchRule) (*SearchRule, error) {
+	c := &SearchRule{SearchRule: r}
+	switch r.Type {
+	case models.SearchSubstring:
+		return c, nil
+	case models.SearchRegex:
+		re, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		c.regex = re
+
+		return c, nil
+	}
+
+	return nil, errors.New("search rule type not supported")
+}
+
+// Returns the names of the search rules that create an issue in the page. A rule creates an issue if
+// its pattern is found and the mode is "contains", or if it is not found and the mode is "not_contains".
+func (p *Parser) search(rules []*SearchRule) []string {
+	issues := []string{}
+	if len(rules) == 0 {
+		return issues
+	}
+
+	html := string(p.body)
+	text := ""
+	if body := p.htmlBodyNode(); body != nil {
+		text = strings.Join(strings.Fields(nodeText(body, nonVisibleElements)), " ")
+	}
+
+	for _, r := range rules {
+		content := html
+		if r.Target == models.SearchText {
+			content = text
+		}
+
+		var found bool
+		if r.regex != nil {
+			found = r.regex.MatchString(content)
+		} else {
+			found = strings.Contains(content, r.Pattern)
+		}
+
+		if found == (r.Mode == models.SearchContains) {
+			issues = append(issues, r.Name)
+		}
+	}
+
+	return issues
+}
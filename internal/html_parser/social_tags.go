@@ -0,0 +1,66 @@
+package html_parser
+
+import (
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"github.com/antchfx/htmlquery"
+)
+
+// Returns the Open Graph and Twitter Card meta tags. The tags are identified either by
+// the property or the name attribute, and only the first occurrence of each tag is used.
+// ex. <meta property="og:title" content="Page Title" />
+// ex. <meta name="twitter:card" content="summary_large_image" />
+func (p *Parser) htmlSocialTags() models.SocialTags {
+	tags := make(map[string]string)
+
+	nodes, err := htmlquery.QueryAll(p.doc, "//meta[@content and (@property or @name)]")
+	if err != nil {
+		return models.SocialTags{}
+	}
+
+	for _, n := range nodes {
+		key := htmlquery.SelectAttr(n, "property")
+		if key == "" {
+			key = htmlquery.SelectAttr(n, "name")
+		}
+
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !strings.HasPrefix(key, "og:") && !strings.HasPrefix(key, "twitter:") {
+			continue
+		}
+
+		if _, ok := tags[key]; !ok {
+			tags[key] = strings.TrimSpace(htmlquery.SelectAttr(n, "content"))
+		}
+	}
+
+	s := models.SocialTags{
+		OGTitle:            tags["og:title"],
+		OGDescription:      tags["og:description"],
+		OGImage:            tags["og:image"],
+		OGType:             tags["og:type"],
+		OGSiteName:         tags["og:site_name"],
+		TwitterCard:        tags["twitter:card"],
+		TwitterTitle:       tags["twitter:title"],
+		TwitterDescription: tags["twitter:description"],
+		TwitterImage:       tags["twitter:image"],
+	}
+
+	if s.OGImage != "" {
+		u, err := p.absoluteURL(s.OGImage)
+		if err == nil {
+			s.OGImageURL = u.String()
+		}
+	}
+
+	if tags["og:url"] != "" {
+		u, err := p.absoluteURL(tags["og:url"])
+		if err == nil {
+			s.OGURL = u.String()
+		}
+	}
+
+	return s
+}
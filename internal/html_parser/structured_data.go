@@ -0,0 +1,284 @@
+package html_parser
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+)
+
+const (
+	structuredDataJSONLD    = "json-ld"
+	structuredDataMicrodata = "microdata"
+	structuredDataRDFa      = "rdfa"
+)
+
+// Returns the structured data items found in the JSON-LD scripts, as well as
+// the items defined with microdata and RDFa attributes.
+func (p *Parser) structuredData() []models.StructuredData {
+	data := p.htmlJSONLD()
+	data = append(data, p.htmlMicrodata()...)
+	data = append(data, p.htmlRDFa()...)
+
+	return data
+}
+
+// Returns the top level items of the JSON-LD scripts, including the items in the "@graph" array.
+// Scripts with invalid JSON are returned as a single invalid item.
+// ex. <script type="application/ld+json">{"@type": "Product", "name": "Test"}</script>
+func (p *Parser) htmlJSONLD() []models.StructuredData {
+	data := []models.StructuredData{}
+
+	nodes, err := htmlquery.QueryAll(p.doc, "//script[@type=\"application/ld+json\"]")
+	if err != nil {
+		return data
+	}
+
+	var items func(v interface{})
+	items = func(v interface{}) {
+		switch t := v.(type) {
+		case []interface{}:
+			for _, i := range t {
+				items(i)
+			}
+		case map[string]interface{}:
+			if graph, ok := t["@graph"]; ok {
+				items(graph)
+			}
+
+			itemType := jsonLDType(t["@type"])
+			if itemType == "" {
+				return
+			}
+
+			properties := []string{}
+			for k := range t {
+				if !strings.HasPrefix(k, "@") {
+					properties = append(properties, k)
+				}
+			}
+			sort.Strings(properties)
+
+			data = append(data, models.StructuredData{
+				Format:     structuredDataJSONLD,
+				Type:       itemType,
+				Properties: properties,
+				Valid:      true,
+			})
+		}
+	}
+
+	for _, n := range nodes {
+		var v interface{}
+		err := json.Unmarshal([]byte(htmlquery.InnerText(n)), &v)
+		if err != nil {
+			data = append(data, models.StructuredData{Format: structuredDataJSONLD})
+			continue
+		}
+
+		items(v)
+	}
+
+	return data
+}
+
+// Returns the top level microdata items and the names of their properties. Items are not valid
+// if their itemtype is not an absolute URL or if they reference elements that don't exist.
+// ex. <div itemscope itemtype="https://schema.org/Product"><span itemprop="name">Test</span></div>
+func (p *Parser) htmlMicrodata() []models.StructuredData {
+	data := []models.StructuredData{}
+
+	nodes, err := htmlquery.QueryAll(p.doc, "//*[@itemscope and @itemtype and not(@itemprop)]")
+	if err != nil {
+		return data
+	}
+
+	for _, n := range nodes {
+		data = append(data, models.StructuredData{
+			Format:     structuredDataMicrodata,
+			Type:       schemaType(htmlquery.SelectAttr(n, "itemtype")),
+			Properties: itemProperties(n, "itemprop", "itemscope"),
+			Valid:      p.validMicrodataItem(n),
+		})
+	}
+
+	return data
+}
+
+// Returns the top level RDFa items and the names of their properties. Items are not valid
+// if their type can't be resolved to a URL, because there is no vocabulary or prefix for it.
+// ex. <div vocab="https://schema.org/" typeof="Product"><span property="name">Test</span></div>
+func (p *Parser) htmlRDFa() []models.StructuredData {
+	data := []models.StructuredData{}
+
+	nodes, err := htmlquery.QueryAll(p.doc, "//*[@typeof and not(@property)]")
+	if err != nil {
+		return data
+	}
+
+	for _, n := range nodes {
+		data = append(data, models.StructuredData{
+			Format:     structuredDataRDFa,
+			Type:       schemaType(htmlquery.SelectAttr(n, "typeof")),
+			Properties: itemProperties(n, "property", "typeof"),
+			Valid:      validRDFaItem(n),
+		})
+	}
+
+	return data
+}
+
+// Returns true if all the types in the itemtype of the microdata item are absolute URLs
+// and all the ids in its itemref attribute are in the document.
+func (p *Parser) validMicrodataItem(n *html.Node) bool {
+	types := strings.Fields(htmlquery.SelectAttr(n, "itemtype"))
+	if len(types) == 0 {
+		return false
+	}
+
+	for _, t := range types {
+		u, err := url.Parse(t)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return false
+		}
+	}
+
+	for _, id := range strings.Fields(htmlquery.SelectAttr(n, "itemref")) {
+		ref, err := htmlquery.Query(p.doc, "//*[@id=\""+id+"\"]")
+		if err != nil || ref == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns true if all the types in the typeof attribute of the RDFa item can be resolved.
+// Types are resolved if they are absolute URLs, if they use the "schema" prefix or a prefix
+// defined in the item or its ancestors, or if a vocab is defined in the item or its ancestors.
+func validRDFaItem(n *html.Node) bool {
+	types := strings.Fields(htmlquery.SelectAttr(n, "typeof"))
+	if len(types) == 0 {
+		return false
+	}
+
+	vocab := false
+	prefixes := map[string]bool{"schema": true}
+	for a := n; a != nil; a = a.Parent {
+		if a.Type != html.ElementNode {
+			continue
+		}
+
+		if htmlquery.SelectAttr(a, "vocab") != "" {
+			vocab = true
+		}
+
+		// The prefix attribute is a list of "prefix: URL" pairs.
+		for _, f := range strings.Fields(htmlquery.SelectAttr(a, "prefix")) {
+			if strings.HasSuffix(f, ":") {
+				prefixes[strings.TrimSuffix(f, ":")] = true
+			}
+		}
+	}
+
+	for _, t := range types {
+		u, err := url.Parse(t)
+		if err == nil && u.Scheme != "" && u.Host != "" {
+			continue
+		}
+
+		if i := strings.Index(t, ":"); i > -1 {
+			if !prefixes[t[:i]] {
+				return false
+			}
+			continue
+		}
+
+		if !vocab {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns the sorted and unique property names of an item. The properties of nested
+// items, the ones defined inside elements with the scope attribute, are not included.
+func itemProperties(n *html.Node, propAttr, scopeAttr string) []string {
+	seen := make(map[string]bool)
+	properties := []string{}
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+
+			for _, prop := range strings.Fields(htmlquery.SelectAttr(c, propAttr)) {
+				prop = schemaType(prop)
+				if !seen[prop] {
+					seen[prop] = true
+					properties = append(properties, prop)
+				}
+			}
+
+			if hasAttr(c, scopeAttr) {
+				continue
+			}
+
+			walk(c)
+		}
+	}
+
+	walk(n)
+	sort.Strings(properties)
+
+	return properties
+}
+
+// Returns the first type of a JSON-LD "@type", which can be either a string or an array.
+func jsonLDType(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return schemaType(t)
+	case []interface{}:
+		if len(t) > 0 {
+			return jsonLDType(t[0])
+		}
+	}
+
+	return ""
+}
+
+// Returns the first type name removing the vocabulary,
+// ex. "https://schema.org/Product" and "schema:Product" return "Product".
+func schemaType(s string) string {
+	f := strings.Fields(s)
+	if len(f) == 0 {
+		return ""
+	}
+
+	t := f[0]
+	if i := strings.LastIndexAny(t, "/:#"); i > -1 {
+		t = t[i+1:]
+	}
+
+	return t
+}
+
+// Returns true if the node has the attribute.
+func hasAttr(n *html.Node, attr string) bool {
+	for _, a := range n.Attr {
+		if a.Key == attr {
+			return true
+		}
+	}
+
+	return false
+}
@@ -6,14 +6,18 @@ import (
 	"net/http"
 
 	"github.com/stjudewashere/seonaut/internal/crawler"
+	"github.com/stjudewashere/seonaut/internal/duplicates"
 	"github.com/stjudewashere/seonaut/internal/export"
+	"github.com/stjudewashere/seonaut/internal/hreflang"
 	"github.com/stjudewashere/seonaut/internal/issue"
+	"github.com/stjudewashere/seonaut/internal/keywords"
 	"github.com/stjudewashere/seonaut/internal/project"
 	"github.com/stjudewashere/seonaut/internal/projectview"
 	"github.com/stjudewashere/seonaut/internal/pubsub"
 	"github.com/stjudewashere/seonaut/internal/renderer"
 	"github.com/stjudewashere/seonaut/internal/report"
 	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
 	"github.com/stjudewashere/seonaut/internal/user"
 
 	"github.com/gorilla/securecookie"
@@ -39,6 +43,9 @@ type Services struct {
 	ReportManager      *report_manager.ReportManager
 	PubSubBroker       *pubsub.Broker
 	ExportService      *export.Exporter
+	DuplicatesService  *duplicates.Service
+	KeywordsService    *keywords.Service
+	HreflangService    *hreflang.Service
 }
 
 // App is the server application, and it contains all the needed services to handle requests.
@@ -55,6 +62,9 @@ type App struct {
 	projectViewService *projectview.Service
 	pubsubBroker       *pubsub.Broker
 	exportService      *export.Exporter
+	duplicatesService  *duplicates.Service
+	keywordsService    *keywords.Service
+	hreflangService    *hreflang.Service
 }
 
 // PageView is the data structure used to render the html templates.
@@ -76,6 +86,14 @@ func NewApp(c *HTTPServerConfig, s *Services) *App {
 		log.Fatal(err)
 	}
 
+	// Make sure all the registered issue types can be translated with the translations
+	// file and the registry, then add the titles and descriptions declared in the registry.
+	err = reporter_errors.Validate(renderer.HasTranslation)
+	if err != nil {
+		log.Fatal(err)
+	}
+	renderer.AddTranslations(reporter_errors.Translations())
+
 	authKeyOne := securecookie.GenerateRandomKey(64)
 	encryptionKeyOne := securecookie.GenerateRandomKey(32)
 
@@ -103,6 +121,9 @@ func NewApp(c *HTTPServerConfig, s *Services) *App {
 		projectViewService: s.ProjectViewService,
 		pubsubBroker:       s.PubSubBroker,
 		exportService:      s.ExportService,
+		duplicatesService:  s.DuplicatesService,
+		keywordsService:    s.KeywordsService,
+		hreflangService:    s.HreflangService,
 	}
 }
 
@@ -125,6 +146,7 @@ func (app *App) Run() {
 	http.HandleFunc("/crawl-ws", app.requireAuth(app.handleCrawlWs))
 	http.HandleFunc("/issues", app.requireAuth(app.handleIssues))
 	http.HandleFunc("/issues/view", app.requireAuth(app.handleIssuesView))
+	http.HandleFunc("/issues/custom", app.requireAuth(app.handleCustomIssuesView))
 	http.HandleFunc("/dashboard", app.requireAuth(app.handleDashboard))
 	http.HandleFunc("/download", app.requireAuth(app.handleDownloadCSV))
 	http.HandleFunc("/sitemap", app.requireAuth(app.handleSitemap))
@@ -134,6 +156,17 @@ func (app *App) Run() {
 	http.HandleFunc("/signout", app.requireAuth(app.handleSignout))
 	http.HandleFunc("/account", app.requireAuth(app.handleAccount))
 	http.HandleFunc("/explorer", app.requireAuth(app.handleExplorer))
+	http.HandleFunc("/duplicates", app.requireAuth(app.handleDuplicates))
+	http.HandleFunc("/keywords", app.requireAuth(app.handleKeywords))
+	http.HandleFunc("/keywords/cannibalization", app.requireAuth(app.handleCannibalization))
+	http.HandleFunc("/hreflangs", app.requireAuth(app.handleHreflangs))
+	http.HandleFunc("/checks", app.requireAuth(app.handleChecks))
+	http.HandleFunc("/extractors", app.requireAuth(app.handleExtractors))
+	http.HandleFunc("/extractors/delete", app.requireAuth(app.handleDeleteExtractor))
+	http.HandleFunc("/search-rules", app.requireAuth(app.handleSearchRules))
+	http.HandleFunc("/search-rules/delete", app.requireAuth(app.handleDeleteSearchRule))
+	http.HandleFunc("/suppression-rules", app.requireAuth(app.handleSuppressionRules))
+	http.HandleFunc("/suppression-rules/delete", app.requireAuth(app.handleDeleteSuppressionRule))
 	http.HandleFunc("/signup", app.handleSignup)
 	http.HandleFunc("/signin", app.handleSignin)
 
@@ -0,0 +1,35 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+type ChecksView struct {
+	Categories []reporter_errors.IssueCategory
+	Total      int
+}
+
+// handleChecks handles the available checks request, listing all the registered
+// issue types grouped by category.
+func (app *App) handleChecks(w http.ResponseWriter, r *http.Request) {
+	user, ok := app.userService.GetUserFromContext(r.Context())
+	if ok == false {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	view := ChecksView{
+		Categories: reporter_errors.GetIssueCategories(),
+		Total:      len(reporter_errors.GetAllIssueTypes()),
+	}
+
+	v := &PageView{
+		Data:      view,
+		User:      *user,
+		PageTitle: "CHECKS_VIEW",
+	}
+
+	app.renderer.RenderTemplate(w, "checks", v)
+}
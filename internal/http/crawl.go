@@ -279,8 +279,12 @@ func (app *App) handleCrawlWs(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Helper function to start crawling a project.
+// Helper function to start crawling a project. The project is locked until
+// the crawl and its issues are done.
 func (app *App) startCrawler(p models.Project) {
+	unlock := app.crawlerService.LockProject(p.Id)
+	defer unlock()
+
 	log.Printf("Crawling %s\n", p.URL)
 	crawl, err := app.crawlerService.StartCrawler(p)
 	if err != nil {
@@ -292,7 +296,13 @@ func (app *App) startCrawler(p models.Project) {
 	log.Printf("Crawled %d pages at %s\n", crawl.TotalURLs, p.URL)
 
 	app.pubsubBroker.Publish(fmt.Sprintf("crawl-%d", p.Id), &pubsub.Message{Name: "IssuesInit"})
+	err = app.duplicatesService.BuildClusters(crawl, p.Thresholds.DuplicateThreshold)
+	if err != nil {
+		log.Printf("BuildClusters: %s %v\n", p.URL, err)
+	}
+
 	app.reportManager.CreateMultipageIssues(crawl)
+	app.issueService.SuppressIssues(crawl, app.projectService.GetSuppressionRules(&p))
 	app.issueService.SaveCrawlIssuesCount(crawl)
 	app.pubsubBroker.Publish(fmt.Sprintf("crawl-%d", p.Id), &pubsub.Message{Name: "CrawlEnd", Data: crawl.TotalURLs})
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/stjudewashere/seonaut/internal/issue"
 	"github.com/stjudewashere/seonaut/internal/models"
 	"github.com/stjudewashere/seonaut/internal/projectview"
 	"github.com/stjudewashere/seonaut/internal/report"
@@ -28,6 +29,9 @@ type DashboardView struct {
 	CanonicalCount *report.CanonicalCount
 	AltCount       *report.AltCount
 	SchemeCount    *report.SchemeCount
+	Accessibility  []issue.IssueGroup
+	Security       report.SecurityHeadersCount
+	Caching        report.CacheCount
 }
 
 // handleDashboard handles the dashboard of a project.
@@ -68,6 +72,9 @@ func (app *App) handleDashboard(w http.ResponseWriter, r *http.Request) {
 		CanonicalCount: app.reportService.GetCanonicalCount(pv.Crawl.Id),
 		AltCount:       app.reportService.GetImageAltCount(pv.Crawl.Id),
 		SchemeCount:    app.reportService.GetSchemeCount(pv.Crawl.Id),
+		Accessibility:  app.issueService.GetIssuesCount(pv.Crawl.Id).AccessibilityIssues,
+		Security:       *app.reportService.GetSecurityHeadersCount(pv.Crawl.Id),
+		Caching:        *app.reportService.GetCacheCount(pv.Crawl.Id),
 	}
 
 	pageView := &PageView{
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/duplicates"
+	"github.com/stjudewashere/seonaut/internal/projectview"
+)
+
+type DuplicatesView struct {
+	ProjectView *projectview.ProjectView
+	Clusters    []duplicates.Cluster
+}
+
+// handleDuplicates handles the duplicate content clusters request.
+// It expects a query parameter "pid" containing the project ID. When the request method is POST
+// it expects the "rid" form value with the ID of the PageReport selected as the canonical URL
+// of its cluster.
+func (app *App) handleDuplicates(w http.ResponseWriter, r *http.Request) {
+	user, ok := app.userService.GetUserFromContext(r.Context())
+	if ok == false {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pv, err := app.projectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		rid, err := strconv.ParseInt(r.FormValue("rid"), 10, 64)
+		if err == nil {
+			err = app.duplicatesService.SetCanonical(pv.Crawl.Id, rid)
+		}
+
+		if err != nil {
+			log.Printf("handleDuplicates SetCanonical: %v\n", err)
+		}
+
+		http.Redirect(w, r, fmt.Sprintf("/duplicates?pid=%d", pid), http.StatusSeeOther)
+		return
+	}
+
+	view := DuplicatesView{
+		ProjectView: pv,
+		Clusters:    app.duplicatesService.GetClusters(pv.Crawl.Id),
+	}
+
+	v := &PageView{
+		Data:      view,
+		User:      *user,
+		PageTitle: "DUPLICATES_VIEW",
+	}
+
+	app.renderer.RenderTemplate(w, "duplicates", v)
+}
@@ -9,16 +9,19 @@ import (
 )
 
 type ExplorerView struct {
-	ProjectView   *projectview.ProjectView
-	Term          string
-	PaginatorView models.PaginatorView
+	ProjectView     *projectview.ProjectView
+	Term            string
+	Sort            string
+	ExtractionNames []string
+	PaginatorView   models.PaginatorView
 }
 
 // handleExplorer handles the URL explorer request.
 // It performas a search of pagereports based on the "term" parameter. In case the "term" parameter
 // is empty, it loads all the pagereports.
 // It expects a query parameter "pid" containing the project ID, the "p" parameter containing the current
-// page in the paginator, and the "term" parameter used to perform the pagereport search.
+// page in the paginator, the "term" parameter used to perform the pagereport search and the "sort"
+// parameter with the column used to sort the pagereports.
 func (app *App) handleExplorer(w http.ResponseWriter, r *http.Request) {
 	// Get user from the request's context
 	user, ok := app.userService.GetUserFromContext(r.Context())
@@ -48,18 +51,21 @@ func (app *App) handleExplorer(w http.ResponseWriter, r *http.Request) {
 	}
 
 	term := r.URL.Query().Get("term")
+	sort := r.URL.Query().Get("sort")
 
 	// Get the paginated reports
-	paginatorView, err := app.reportService.GetPaginatedReports(pv.Crawl.Id, page, term)
+	paginatorView, err := app.reportService.GetPaginatedReports(pv.Crawl.Id, page, term, sort)
 	if err != nil {
 		http.Redirect(w, r, "/", http.StatusSeeOther)
 		return
 	}
 
 	view := ExplorerView{
-		ProjectView:   pv,
-		Term:          term,
-		PaginatorView: paginatorView,
+		ProjectView:     pv,
+		Term:            term,
+		Sort:            sort,
+		ExtractionNames: app.reportService.GetExtractionNames(pv.Crawl.Id),
+		PaginatorView:   paginatorView,
 	}
 
 	v := &PageView{
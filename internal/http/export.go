@@ -76,8 +76,8 @@ func (app *App) handleDownloadCSV(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Add("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.csv\"", fileName))
 
-	cw := encoding.NewCSVWriter(w)
-	prStream := app.reportService.GetPageReporsByIssueType(pv.Crawl.Id, eid)
+	cw := encoding.NewCSVWriter(w, app.reportService.GetExtractionNames(pv.Crawl.Id))
+	prStream := app.reportService.GetPageReportsWithExtractions(pv.Crawl.Id, eid)
 
 	for p := range prStream {
 		cw.Write(p)
@@ -157,6 +157,9 @@ func (app *App) handleExportResources(w http.ResponseWriter, r *http.Request) {
 		"audios":    app.exportService.ExportAudios,
 		"videos":    app.exportService.ExportVideos,
 		"hreflangs": app.exportService.ExportHreflangs,
+		"broken":    app.exportService.ExportBrokenResources,
+		"schema":    app.exportService.ExportStructuredData,
+		"keywords":  app.exportService.ExportKeywords,
 	}
 
 	e, ok := m[t]
@@ -0,0 +1,106 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// handleExtractors handles the list of custom extractors of a project and the form to add new ones.
+// It expects a query parameter "pid" containing the project ID. When the request method is POST
+// it expects the "name", "type" and "expression" form values of the new extractor.
+func (app *App) handleExtractors(w http.ResponseWriter, r *http.Request) {
+	user, ok := app.userService.GetUserFromContext(r.Context())
+	if ok == false {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := app.projectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	data := &struct {
+		Project    models.Project
+		Extractors []models.Extractor
+		Extractor  models.Extractor
+		Error      string
+	}{
+		Project: p,
+	}
+
+	if r.Method == http.MethodPost {
+		err := r.ParseForm()
+		if err != nil {
+			log.Printf("handleExtractors ParseForm: %v\n", err)
+			http.Redirect(w, r, "/", http.StatusSeeOther)
+			return
+		}
+
+		data.Extractor = models.Extractor{
+			Name:       strings.TrimSpace(r.FormValue("name")),
+			Type:       r.FormValue("type"),
+			Expression: strings.TrimSpace(r.FormValue("expression")),
+		}
+
+		err = app.projectService.AddExtractor(&p, &data.Extractor)
+		if err == nil {
+			http.Redirect(w, r, fmt.Sprintf("/extractors?pid=%d", pid), http.StatusSeeOther)
+			return
+		}
+
+		data.Error = err.Error()
+	}
+
+	data.Extractors = app.projectService.GetExtractors(&p)
+
+	app.renderer.RenderTemplate(w, "extractors", &PageView{
+		User:      *user,
+		PageTitle: "EXTRACTORS_VIEW",
+		Data:      data,
+	})
+}
+
+// handleDeleteExtractor handles the deletion of a project's custom extractor.
+// It expects the query parameters "pid" containing the project ID and "id" containing the extractor ID.
+func (app *App) handleDeleteExtractor(w http.ResponseWriter, r *http.Request) {
+	user, ok := app.userService.GetUserFromContext(r.Context())
+	if ok == false {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := app.projectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	app.projectService.DeleteExtractor(&p, id)
+
+	http.Redirect(w, r, fmt.Sprintf("/extractors?pid=%d", pid), http.StatusSeeOther)
+}
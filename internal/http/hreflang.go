@@ -0,0 +1,51 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/hreflang"
+	"github.com/stjudewashere/seonaut/internal/projectview"
+)
+
+type HreflangsView struct {
+	ProjectView *projectview.ProjectView
+	Clusters    []hreflang.Cluster
+	Limit       int
+}
+
+// handleHreflangs handles the hreflang matrix request, showing the hreflang annotations
+// of each cluster of pages. It expects a query parameter "pid" containing the project ID.
+func (app *App) handleHreflangs(w http.ResponseWriter, r *http.Request) {
+	user, ok := app.userService.GetUserFromContext(r.Context())
+	if ok == false {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pv, err := app.projectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	view := HreflangsView{
+		ProjectView: pv,
+		Clusters:    app.hreflangService.GetClusters(pv.Crawl.Id),
+		Limit:       hreflang.ClustersLimit,
+	}
+
+	v := &PageView{
+		Data:      view,
+		User:      *user,
+		PageTitle: "HREFLANGS_VIEW",
+	}
+
+	app.renderer.RenderTemplate(w, "hreflangs", v)
+}
@@ -10,18 +10,23 @@ import (
 )
 
 type IssuesGroupView struct {
-	ProjectView *projectview.ProjectView
-	IssueCount  *issue.IssueCount
+	ProjectView      *projectview.ProjectView
+	IssueCount       *issue.IssueCount
+	Suppressed       bool
+	SuppressedIssues []issue.IssueGroup
 }
 
 type IssuesView struct {
 	ProjectView   *projectview.ProjectView
 	Eid           string
+	Custom        bool
+	Suppressed    bool
 	PaginatorView models.PaginatorView
 }
 
 // handleIssues handles the issues view of a project.
-// It expects a query parameter "pid" containing the project ID.
+// It expects a query parameter "pid" containing the project ID. If the "suppressed"
+// query parameter is set it lists the suppressed issues instead.
 func (app *App) handleIssues(w http.ResponseWriter, r *http.Request) {
 	user, ok := app.userService.GetUserFromContext(r.Context())
 	if ok == false {
@@ -50,9 +55,13 @@ func (app *App) handleIssues(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	suppressed := r.URL.Query().Get("suppressed") == "1"
+
 	ig := IssuesGroupView{
-		ProjectView: pv,
-		IssueCount:  app.issueService.GetIssuesCount(pv.Crawl.Id),
+		ProjectView:      pv,
+		IssueCount:       app.issueService.GetIssuesCount(pv.Crawl.Id),
+		Suppressed:       suppressed,
+		SuppressedIssues: app.issueService.GetSuppressedIssues(pv.Crawl.Id),
 	}
 
 	v := &PageView{
@@ -66,7 +75,8 @@ func (app *App) handleIssues(w http.ResponseWriter, r *http.Request) {
 
 // handleIssuesView handles the view of project's specific issue type.
 // It expects a query parameter "pid" containing the project ID and an "eid" parameter
-// containing the issue type.
+// containing the issue type. If the "suppressed" query parameter is set it lists the
+// pages with the suppressed issues instead.
 func (app *App) handleIssuesView(w http.ResponseWriter, r *http.Request) {
 	user, ok := app.userService.GetUserFromContext(r.Context())
 	if ok == false {
@@ -101,7 +111,9 @@ func (app *App) handleIssuesView(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	paginatorView, err := app.issueService.GetPaginatedReportsByIssue(pv.Crawl.Id, page, eid)
+	suppressed := r.URL.Query().Get("suppressed") == "1"
+
+	paginatorView, err := app.issueService.GetPaginatedReportsByIssue(pv.Crawl.Id, page, eid, suppressed)
 	if err != nil {
 		http.Redirect(w, r, "/", http.StatusSeeOther)
 
@@ -111,6 +123,67 @@ func (app *App) handleIssuesView(w http.ResponseWriter, r *http.Request) {
 	data := IssuesView{
 		ProjectView:   pv,
 		Eid:           eid,
+		Suppressed:    suppressed,
+		PaginatorView: paginatorView,
+	}
+
+	v := &PageView{
+		Data:      data,
+		User:      *user,
+		PageTitle: "ISSUES_DETAIL",
+	}
+
+	app.renderer.RenderTemplate(w, "issues_view", v)
+}
+
+// handleCustomIssuesView handles the view of a project's custom issue created by a search rule.
+// It expects a query parameter "pid" containing the project ID and a "name" parameter
+// containing the name of the search rule.
+func (app *App) handleCustomIssuesView(w http.ResponseWriter, r *http.Request) {
+	user, ok := app.userService.GetUserFromContext(r.Context())
+	if ok == false {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+
+		return
+	}
+
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+
+		return
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("p"))
+	if err != nil {
+		page = 1
+	}
+
+	pv, err := app.projectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+
+		return
+	}
+
+	paginatorView, err := app.issueService.GetPaginatedReportsByCustomIssue(pv.Crawl.Id, page, name)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+
+		return
+	}
+
+	data := IssuesView{
+		ProjectView:   pv,
+		Eid:           name,
+		Custom:        true,
 		PaginatorView: paginatorView,
 	}
 
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/keywords"
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/projectview"
+)
+
+type KeywordsView struct {
+	ProjectView *projectview.ProjectView
+	Words       int
+	Keywords    []models.SiteKeyword
+}
+
+type CannibalizationView struct {
+	ProjectView *projectview.ProjectView
+	Clusters    []keywords.Cluster
+}
+
+// handleKeywords handles the site keywords request.
+// It expects a query parameter "pid" containing the project ID and an optional "n"
+// parameter with the number of words of the terms, which defaults to 1.
+func (app *App) handleKeywords(w http.ResponseWriter, r *http.Request) {
+	user, ok := app.userService.GetUserFromContext(r.Context())
+	if ok == false {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pv, err := app.projectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	words, err := strconv.Atoi(r.URL.Query().Get("n"))
+	if err != nil || words < 1 || words > keywords.MaxWords {
+		words = 1
+	}
+
+	view := KeywordsView{
+		ProjectView: pv,
+		Words:       words,
+		Keywords:    app.keywordsService.GetSiteKeywords(pv.Crawl.Id, words),
+	}
+
+	v := &PageView{
+		Data:      view,
+		User:      *user,
+		PageTitle: "KEYWORDS_VIEW",
+	}
+
+	app.renderer.RenderTemplate(w, "keywords", v)
+}
+
+// handleCannibalization handles the keyword cannibalization request, listing the pages
+// that share the same primary term. It expects a query parameter "pid" containing the project ID.
+func (app *App) handleCannibalization(w http.ResponseWriter, r *http.Request) {
+	user, ok := app.userService.GetUserFromContext(r.Context())
+	if ok == false {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pv, err := app.projectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	view := CannibalizationView{
+		ProjectView: pv,
+		Clusters:    app.keywordsService.GetCannibalization(pv.Crawl.Id),
+	}
+
+	v := &PageView{
+		Data:      view,
+		User:      *user,
+		PageTitle: "CANNIBALIZATION_VIEW",
+	}
+
+	app.renderer.RenderTemplate(w, "cannibalization", v)
+}
@@ -98,6 +98,16 @@ func (app *App) handleProjectAdd(w http.ResponseWriter, r *http.Request) {
 			basicAuth = false
 		}
 
+		checkExternalLinks, err := strconv.ParseBool(r.FormValue("check_external_links"))
+		if err != nil {
+			checkExternalLinks = false
+		}
+
+		checkReadability, err := strconv.ParseBool(r.FormValue("check_readability"))
+		if err != nil {
+			checkReadability = false
+		}
+
 		parsedURL, err := url.ParseRequestURI(strings.TrimSpace(u))
 		if err != nil {
 			data.Error = true
@@ -114,6 +124,9 @@ func (app *App) handleProjectAdd(w http.ResponseWriter, r *http.Request) {
 			CrawlSitemap:    crawlSitemap,
 			AllowSubdomains: allowSubdomains,
 			BasicAuth:       basicAuth,
+
+			CheckExternalLinks: checkExternalLinks,
+			CheckReadability:   checkReadability,
 		}
 
 		err = app.projectService.SaveProject(project, user.Id)
@@ -235,6 +248,47 @@ func (app *App) handleProjectEdit(w http.ResponseWriter, r *http.Request) {
 			p.BasicAuth = false
 		}
 
+		p.CheckExternalLinks, err = strconv.ParseBool(r.FormValue("check_external_links"))
+		if err != nil {
+			p.CheckExternalLinks = false
+		}
+
+		p.CheckReadability, err = strconv.ParseBool(r.FormValue("check_readability"))
+		if err != nil {
+			p.CheckReadability = false
+		}
+
+		previousThresholds := p.Thresholds
+		thresholdValue := func(name string, v *int, max int) {
+			n, err := strconv.Atoi(r.FormValue(name))
+			if err == nil && n >= 1 && n <= max {
+				*v = n
+			}
+		}
+
+		thresholdValue("title_min_length", &p.Thresholds.TitleMinLength, 1000)
+		thresholdValue("title_max_length", &p.Thresholds.TitleMaxLength, 1000)
+		thresholdValue("description_min_length", &p.Thresholds.DescriptionMinLength, 1000)
+		thresholdValue("description_max_length", &p.Thresholds.DescriptionMaxLength, 1000)
+		thresholdValue("max_links", &p.Thresholds.MaxLinks, 100000)
+		thresholdValue("min_words", &p.Thresholds.MinWords, 100000)
+		thresholdValue("max_image_size", &p.Thresholds.MaxImageSize, 10240)
+
+		duplicateThreshold, err := strconv.Atoi(r.FormValue("duplicate_threshold"))
+		if err == nil && duplicateThreshold >= models.MinDuplicateThreshold && duplicateThreshold <= 100 {
+			p.Thresholds.DuplicateThreshold = duplicateThreshold
+		}
+
+		if p.Thresholds.TitleMinLength > p.Thresholds.TitleMaxLength {
+			p.Thresholds.TitleMinLength = previousThresholds.TitleMinLength
+			p.Thresholds.TitleMaxLength = previousThresholds.TitleMaxLength
+		}
+
+		if p.Thresholds.DescriptionMinLength > p.Thresholds.DescriptionMaxLength {
+			p.Thresholds.DescriptionMinLength = previousThresholds.DescriptionMinLength
+			p.Thresholds.DescriptionMaxLength = previousThresholds.DescriptionMaxLength
+		}
+
 		err = app.projectService.UpdateProject(&p)
 		if err != nil {
 			data.Error = true
@@ -243,6 +297,10 @@ func (app *App) handleProjectEdit(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if p.Thresholds != previousThresholds {
+			go app.updateThresholdIssues(p)
+		}
+
 		http.Redirect(w, r, "/", http.StatusSeeOther)
 
 		return
@@ -250,3 +308,29 @@ func (app *App) handleProjectEdit(w http.ResponseWriter, r *http.Request) {
 
 	app.renderer.RenderTemplate(w, "project_edit", pageView)
 }
+
+// Helper function to re-evaluate the issues of the project's last crawl
+// once the project's thresholds have changed. The project is locked, so the
+// update waits for any crawl in progress to end before starting.
+func (app *App) updateThresholdIssues(p models.Project) {
+	unlock := app.crawlerService.LockProject(p.Id)
+	defer unlock()
+
+	crawl := app.projectService.GetLastCrawl(&p)
+
+	// Skip the project if it has not been crawled yet or if the issues of
+	// its last crawl were never created, ex. the server stopped during the crawl.
+	if crawl.Id == 0 || !crawl.IssuesEnd.Valid {
+		return
+	}
+
+	log.Printf("Updating threshold issues %s\n", p.URL)
+	err := app.duplicatesService.BuildClusters(&crawl, p.Thresholds.DuplicateThreshold)
+	if err != nil {
+		log.Printf("BuildClusters: %s %v\n", p.URL, err)
+	}
+
+	app.reportManager.UpdateThresholdIssues(&crawl, &p.Thresholds)
+	app.issueService.SuppressIssues(&crawl, app.projectService.GetSuppressionRules(&p))
+	app.issueService.SaveCrawlIssuesCount(&crawl)
+}
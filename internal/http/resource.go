@@ -6,10 +6,20 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/stjudewashere/seonaut/internal/models"
 	"github.com/stjudewashere/seonaut/internal/projectview"
 	"github.com/stjudewashere/seonaut/internal/report"
 )
 
+// Link positions that can be used to filter the inlinks tab.
+var linkPositions = map[string]bool{
+	models.LinkPositionMain:   true,
+	models.LinkPositionNav:    true,
+	models.LinkPositionHeader: true,
+	models.LinkPositionFooter: true,
+	models.LinkPositionAside:  true,
+}
+
 // handleResourcesView handles the HTTP request for the resources view page.
 //
 // It expects the following query parameters:
@@ -19,6 +29,7 @@ import (
 // - "ep" the explorer page number from which the user loaded this resource.
 // - "t" the tab to be loaded, which defaults to the details tab.
 // - "p" the number of page to be loaded, in case the resource page has pagination.
+// - "pos" the link position used to filter the inlinks tab.
 func (app *App) handleResourcesView(w http.ResponseWriter, r *http.Request) {
 	user, ok := app.userService.GetUserFromContext(r.Context())
 	if ok == false {
@@ -62,6 +73,11 @@ func (app *App) handleResourcesView(w http.ResponseWriter, r *http.Request) {
 		page = 1
 	}
 
+	position := r.URL.Query().Get("pos")
+	if _, ok := linkPositions[position]; !ok {
+		position = ""
+	}
+
 	pv, err := app.projectViewService.GetProjectView(pid, user.Id)
 	if err != nil {
 		log.Printf("serveResourcesView GetProjectView: %v\n", err)
@@ -76,12 +92,14 @@ func (app *App) handleResourcesView(w http.ResponseWriter, r *http.Request) {
 		Eid            string
 		Ep             string
 		Tab            string
+		Position       string
 	}{
 		ProjectView:    pv,
 		Eid:            eid,
 		Ep:             ep,
 		Tab:            tab,
-		PageReportView: app.reportService.GetPageReport(rid, pv.Crawl.Id, tab, page),
+		Position:       position,
+		PageReportView: app.reportService.GetPageReport(rid, pv.Crawl.Id, tab, page, position),
 	}
 
 	pageView := &PageView{
@@ -0,0 +1,108 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// handleSearchRules handles the list of custom search rules of a project and the form to add new ones.
+// It expects a query parameter "pid" containing the project ID. When the request method is POST
+// it expects the "name", "pattern", "type", "mode" and "target" form values of the new search rule.
+func (app *App) handleSearchRules(w http.ResponseWriter, r *http.Request) {
+	user, ok := app.userService.GetUserFromContext(r.Context())
+	if ok == false {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := app.projectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	data := &struct {
+		Project     models.Project
+		SearchRules []models.SearchRule
+		SearchRule  models.SearchRule
+		Error       string
+	}{
+		Project: p,
+	}
+
+	if r.Method == http.MethodPost {
+		err := r.ParseForm()
+		if err != nil {
+			log.Printf("handleSearchRules ParseForm: %v\n", err)
+			http.Redirect(w, r, "/", http.StatusSeeOther)
+			return
+		}
+
+		data.SearchRule = models.SearchRule{
+			Name:    strings.TrimSpace(r.FormValue("name")),
+			Pattern: r.FormValue("pattern"),
+			Type:    r.FormValue("type"),
+			Mode:    r.FormValue("mode"),
+			Target:  r.FormValue("target"),
+		}
+
+		err = app.projectService.AddSearchRule(&p, &data.SearchRule)
+		if err == nil {
+			http.Redirect(w, r, fmt.Sprintf("/search-rules?pid=%d", pid), http.StatusSeeOther)
+			return
+		}
+
+		data.Error = err.Error()
+	}
+
+	data.SearchRules = app.projectService.GetSearchRules(&p)
+
+	app.renderer.RenderTemplate(w, "search_rules", &PageView{
+		User:      *user,
+		PageTitle: "SEARCH_RULES_VIEW",
+		Data:      data,
+	})
+}
+
+// handleDeleteSearchRule handles the deletion of a project's custom search rule.
+// It expects the query parameters "pid" containing the project ID and "id" containing the search rule ID.
+func (app *App) handleDeleteSearchRule(w http.ResponseWriter, r *http.Request) {
+	user, ok := app.userService.GetUserFromContext(r.Context())
+	if ok == false {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := app.projectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	app.projectService.DeleteSearchRule(&p, id)
+
+	http.Redirect(w, r, fmt.Sprintf("/search-rules?pid=%d", pid), http.StatusSeeOther)
+}
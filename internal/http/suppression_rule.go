@@ -0,0 +1,129 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// handleSuppressionRules handles the list of issue suppression rules of a project and the form to add
+// new ones. It expects a query parameter "pid" containing the project ID. When the request method is
+// POST it expects the "issue_type" and "url_pattern" form values of the new suppression rule.
+func (app *App) handleSuppressionRules(w http.ResponseWriter, r *http.Request) {
+	user, ok := app.userService.GetUserFromContext(r.Context())
+	if ok == false {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := app.projectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	data := &struct {
+		Project          models.Project
+		SuppressionRules []models.SuppressionRule
+		SuppressionRule  models.SuppressionRule
+		Categories       []reporter_errors.IssueCategory
+		Error            string
+	}{
+		Project:    p,
+		Categories: reporter_errors.GetIssueCategories(),
+	}
+
+	if r.Method == http.MethodPost {
+		err := r.ParseForm()
+		if err != nil {
+			log.Printf("handleSuppressionRules ParseForm: %v\n", err)
+			http.Redirect(w, r, "/", http.StatusSeeOther)
+			return
+		}
+
+		data.SuppressionRule = models.SuppressionRule{
+			IssueType:  r.FormValue("issue_type"),
+			URLPattern: strings.TrimSpace(r.FormValue("url_pattern")),
+		}
+
+		err = app.projectService.AddSuppressionRule(&p, &data.SuppressionRule)
+		if err == nil {
+			go app.updateSuppressedIssues(p)
+			http.Redirect(w, r, fmt.Sprintf("/suppression-rules?pid=%d", pid), http.StatusSeeOther)
+			return
+		}
+
+		data.Error = err.Error()
+	}
+
+	data.SuppressionRules = app.projectService.GetSuppressionRules(&p)
+
+	app.renderer.RenderTemplate(w, "suppression_rules", &PageView{
+		User:      *user,
+		PageTitle: "SUPPRESSION_RULES_VIEW",
+		Data:      data,
+	})
+}
+
+// handleDeleteSuppressionRule handles the deletion of a project's issue suppression rule.
+// It expects the query parameters "pid" containing the project ID and "id" containing the suppression rule ID.
+func (app *App) handleDeleteSuppressionRule(w http.ResponseWriter, r *http.Request) {
+	user, ok := app.userService.GetUserFromContext(r.Context())
+	if ok == false {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := app.projectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	app.projectService.DeleteSuppressionRule(&p, id)
+	go app.updateSuppressedIssues(p)
+
+	http.Redirect(w, r, fmt.Sprintf("/suppression-rules?pid=%d", pid), http.StatusSeeOther)
+}
+
+// Helper function to apply the project's suppression rules to the issues of its
+// last crawl once the rules have changed. The project is locked so the issues are not
+// updated while they are created by a crawl or re-created by a threshold update.
+func (app *App) updateSuppressedIssues(p models.Project) {
+	unlock := app.crawlerService.LockProject(p.Id)
+	defer unlock()
+
+	crawl := app.projectService.GetLastCrawl(&p)
+
+	// Skip the project if it has not been crawled yet or if the issues of
+	// its last crawl were never created, ex. the server stopped during the crawl.
+	if crawl.Id == 0 || !crawl.IssuesEnd.Valid {
+		return
+	}
+
+	app.issueService.SuppressIssues(&crawl, app.projectService.GetSuppressionRules(&p))
+	app.issueService.SaveCrawlIssuesCount(&crawl)
+}
@@ -10,16 +10,25 @@ type Client struct {
 	client  *http.Client
 }
 
+// Default timeout of the client's requests.
+const defaultTimeout = 10 * time.Second
+
 type ClientOptions struct {
 	UserAgent string
 	BasicAuth bool
 	AuthUser  string
 	AuthPass  string
+	Timeout   time.Duration // Request timeout, defaultTimeout is used if it is not set
 }
 
 func NewClient(options *ClientOptions) *Client {
+	timeout := options.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
 	httpClient := &http.Client{
-		Timeout: 10 * time.Second,
+		Timeout: timeout,
 		CheckRedirect: func(r *http.Request, via []*http.Request) error {
 			return http.ErrUseLastResponse
 		},
@@ -51,3 +60,19 @@ func (c *Client) Get(u string) (*http.Response, error) {
 
 	return resp, nil
 }
+
+// Makes a HEAD request to an URL and returns the http response or an error.
+// It sets the client's User-Agent as well as the BasicAuth details if they are available.
+func (c *Client) Head(u string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodHead, u, nil)
+	if err != nil {
+		return &http.Response{}, err
+	}
+
+	req.Header.Set("User-Agent", c.options.UserAgent)
+	if c.options.BasicAuth {
+		req.SetBasicAuth(c.options.AuthUser, c.options.AuthPass)
+	}
+
+	return c.client.Do(req)
+}
@@ -13,6 +13,7 @@ const (
 	Critical = iota + 1
 	Alert
 	Warning
+	Accessibility
 )
 
 type Cache interface {
@@ -22,11 +23,16 @@ type Cache interface {
 }
 
 type IssueStore interface {
-	GetNumberOfPagesForIssues(int64, string) int
-	FindPageReportIssues(int64, int, string) []models.PageReport
+	GetNumberOfPagesForIssues(int64, string, bool) int
+	FindPageReportIssues(int64, int, string, bool) []models.PageReport
 	FindIssuesByPriority(int64, int) []IssueGroup
-	SaveIssuesCount(int64, int, int, int)
+	SaveIssuesCount(int64, int, int, int, int)
 	SaveEndIssues(int64, time.Time)
+	FindCustomIssues(int64) []IssueGroup
+	GetNumberOfPagesForCustomIssue(int64, string) int
+	FindPageReportsByCustomIssue(int64, int, string) []models.PageReport
+	SuppressIssues(int64, []models.SuppressionRule)
+	FindSuppressedIssues(int64) []IssueGroup
 }
 
 type Service struct {
@@ -41,9 +47,11 @@ type IssueGroup struct {
 }
 
 type IssueCount struct {
-	CriticalIssues []IssueGroup
-	AlertIssues    []IssueGroup
-	WarningIssues  []IssueGroup
+	CriticalIssues      []IssueGroup
+	AlertIssues         []IssueGroup
+	WarningIssues       []IssueGroup
+	AccessibilityIssues []IssueGroup
+	CustomIssues        []IssueGroup
 }
 
 func NewService(s IssueStore, c Cache) *Service {
@@ -61,9 +69,11 @@ func (s *Service) GetIssuesCount(crawlID int64) *IssueCount {
 	err := s.cache.Get(key, v)
 	if err != nil {
 		v = &IssueCount{
-			CriticalIssues: s.store.FindIssuesByPriority(crawlID, Critical),
-			AlertIssues:    s.store.FindIssuesByPriority(crawlID, Alert),
-			WarningIssues:  s.store.FindIssuesByPriority(crawlID, Warning),
+			CriticalIssues:      s.store.FindIssuesByPriority(crawlID, Critical),
+			AlertIssues:         s.store.FindIssuesByPriority(crawlID, Alert),
+			WarningIssues:       s.store.FindIssuesByPriority(crawlID, Warning),
+			AccessibilityIssues: s.store.FindIssuesByPriority(crawlID, Accessibility),
+			CustomIssues:        s.store.FindCustomIssues(crawlID),
 		}
 
 		if err := s.cache.Set(key, v); err != nil {
@@ -74,6 +84,18 @@ func (s *Service) GetIssuesCount(crawlID int64) *IssueCount {
 	return v
 }
 
+// SuppressIssues marks the crawl's issues matching the suppression rules as suppressed.
+// Suppressed issues are excluded from the issue counts, so SaveCrawlIssuesCount must be
+// called afterwards to update them.
+func (s *Service) SuppressIssues(crawl *models.Crawl, rules []models.SuppressionRule) {
+	s.store.SuppressIssues(crawl.Id, rules)
+}
+
+// GetSuppressedIssues returns the crawl's suppressed issues grouped by issue type.
+func (s *Service) GetSuppressedIssues(crawlID int64) []IssueGroup {
+	return s.store.FindSuppressedIssues(crawlID)
+}
+
 // SaveCrawlIssuesCount stores the issue count in the storage and adds the IssueCount to the cache.
 func (s *Service) SaveCrawlIssuesCount(crawl *models.Crawl) {
 
@@ -81,16 +103,18 @@ func (s *Service) SaveCrawlIssuesCount(crawl *models.Crawl) {
 
 	key := fmt.Sprintf("crawl-%d", crawl.Id)
 	ic := &IssueCount{
-		CriticalIssues: s.store.FindIssuesByPriority(crawl.Id, Critical),
-		AlertIssues:    s.store.FindIssuesByPriority(crawl.Id, Alert),
-		WarningIssues:  s.store.FindIssuesByPriority(crawl.Id, Warning),
+		CriticalIssues:      s.store.FindIssuesByPriority(crawl.Id, Critical),
+		AlertIssues:         s.store.FindIssuesByPriority(crawl.Id, Alert),
+		WarningIssues:       s.store.FindIssuesByPriority(crawl.Id, Warning),
+		AccessibilityIssues: s.store.FindIssuesByPriority(crawl.Id, Accessibility),
+		CustomIssues:        s.store.FindCustomIssues(crawl.Id),
 	}
 
 	if err := s.cache.Set(key, ic); err != nil {
 		log.Printf("GetIssuesCount: cacheSet: %v\n", err)
 	}
 
-	var critical, alert, warning int
+	var critical, alert, warning, accessibility int
 
 	for _, v := range ic.CriticalIssues {
 		critical += v.Count
@@ -104,19 +128,55 @@ func (s *Service) SaveCrawlIssuesCount(crawl *models.Crawl) {
 		warning += v.Count
 	}
 
-	s.store.SaveIssuesCount(crawl.Id, critical, alert, warning)
+	for _, v := range ic.AccessibilityIssues {
+		accessibility += v.Count
+	}
+
+	s.store.SaveIssuesCount(crawl.Id, critical, alert, warning, accessibility)
 	s.BuildCrawlCache(crawl)
 }
 
 // Returns a PaginatorView with the corresponding page reports.
-func (s *Service) GetPaginatedReportsByIssue(crawlId int64, currentPage int, issueId string) (models.PaginatorView, error) {
+// If suppressed is true it returns the page reports with the suppressed issues instead.
+func (s *Service) GetPaginatedReportsByIssue(crawlId int64, currentPage int, issueId string, suppressed bool) (models.PaginatorView, error) {
+	paginator, err := newPaginator(s.store.GetNumberOfPagesForIssues(crawlId, issueId, suppressed), currentPage)
+	if err != nil {
+		return models.PaginatorView{}, err
+	}
+
+	paginatorView := models.PaginatorView{
+		Paginator:   paginator,
+		PageReports: s.store.FindPageReportIssues(crawlId, currentPage, issueId, suppressed),
+	}
+
+	return paginatorView, nil
+}
+
+// Returns a PaginatorView with the page reports that have the custom issue created by the
+// project's search rule with the given name.
+func (s *Service) GetPaginatedReportsByCustomIssue(crawlId int64, currentPage int, name string) (models.PaginatorView, error) {
+	paginator, err := newPaginator(s.store.GetNumberOfPagesForCustomIssue(crawlId, name), currentPage)
+	if err != nil {
+		return models.PaginatorView{}, err
+	}
+
+	paginatorView := models.PaginatorView{
+		Paginator:   paginator,
+		PageReports: s.store.FindPageReportsByCustomIssue(crawlId, currentPage, name),
+	}
+
+	return paginatorView, nil
+}
+
+// Returns a Paginator for the current page, or an error if the page is out of bounds.
+func newPaginator(totalPages, currentPage int) (models.Paginator, error) {
 	paginator := models.Paginator{
-		TotalPages:  s.store.GetNumberOfPagesForIssues(crawlId, issueId),
+		TotalPages:  totalPages,
 		CurrentPage: currentPage,
 	}
 
 	if currentPage < 1 || currentPage > paginator.TotalPages {
-		return models.PaginatorView{}, errors.New("Page out of bounds")
+		return paginator, errors.New("Page out of bounds")
 	}
 
 	if currentPage < paginator.TotalPages {
@@ -127,20 +187,17 @@ func (s *Service) GetPaginatedReportsByIssue(crawlId int64, currentPage int, iss
 		paginator.PreviousPage = currentPage - 1
 	}
 
-	paginatorView := models.PaginatorView{
-		Paginator:   paginator,
-		PageReports: s.store.FindPageReportIssues(crawlId, currentPage, issueId),
-	}
-
-	return paginatorView, nil
+	return paginator, nil
 }
 
 func (s *Service) BuildCrawlCache(crawl *models.Crawl) {
 	key := fmt.Sprintf("crawl-%d", crawl.Id)
 	ic := &IssueCount{
-		CriticalIssues: s.store.FindIssuesByPriority(crawl.Id, Critical),
-		AlertIssues:    s.store.FindIssuesByPriority(crawl.Id, Alert),
-		WarningIssues:  s.store.FindIssuesByPriority(crawl.Id, Warning),
+		CriticalIssues:      s.store.FindIssuesByPriority(crawl.Id, Critical),
+		AlertIssues:         s.store.FindIssuesByPriority(crawl.Id, Alert),
+		WarningIssues:       s.store.FindIssuesByPriority(crawl.Id, Warning),
+		AccessibilityIssues: s.store.FindIssuesByPriority(crawl.Id, Accessibility),
+		CustomIssues:        s.store.FindCustomIssues(crawl.Id),
 	}
 	if err := s.cache.Set(key, ic); err != nil {
 		log.Printf("GetIssuesCount: cacheSet: %v\n", err)
@@ -0,0 +1,227 @@
+package keywords
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+const (
+	// MaxTerms is the number of terms of each size kept for a page.
+	MaxTerms = 10
+
+	// MaxWords is the number of words of the longest n-grams.
+	MaxWords = 3
+
+	// Terms of more than one word must appear at least minNgramCount times.
+	minNgramCount = 2
+)
+
+// Matches punctuation and symbol characters, which are not considered part of the words.
+var punctuationRegex = regexp.MustCompile(`[\p{P}\p{S}]+`)
+
+// Returns the lowercased words in the text. Punctuation and symbols are removed.
+func Words(s string) []string {
+	return strings.Fields(punctuationRegex.ReplaceAllString(strings.ToLower(s), " "))
+}
+
+// Count returns the number of times each term appears in the words of a page's main
+// content. Terms are single words, bigrams and trigrams. Stopwords for the language are
+// left out of single word terms, n-grams can't start or end with a stopword and they
+// are left out if they appear less than minNgramCount times.
+func Count(words []string, lang string) map[string]int {
+	stop := Stopwords(lang)
+	counts := map[string]int{}
+	for n := 1; n <= MaxWords; n++ {
+		for i := 0; i+n <= len(words); i++ {
+			gram := words[i : i+n]
+			if !isTermWord(gram[0], stop) || !isTermWord(gram[n-1], stop) {
+				continue
+			}
+
+			counts[strings.Join(gram, " ")]++
+		}
+	}
+
+	for t, c := range counts {
+		if c < minNgramCount && termWords(t) > 1 {
+			delete(counts, t)
+		}
+	}
+
+	return counts
+}
+
+// Extract returns the most frequent terms in the term counts of a page. Up to MaxTerms
+// of each size are returned sorted by count.
+//
+// Each term is checked against the title and the H1 of the page. The primary term is the
+// one covering more words of the content, with the terms in the title or the H1 ranking first.
+func Extract(terms map[string]int, title, h1 string) []models.Keyword {
+	titleText := " " + strings.Join(Words(title), " ") + " "
+	h1Text := " " + strings.Join(Words(h1), " ") + " "
+
+	sized := make([][]models.Keyword, MaxWords+1)
+	for t, c := range terms {
+		n := termWords(t)
+		sized[n] = append(sized[n], models.Keyword{
+			Term:    t,
+			Words:   n,
+			Count:   c,
+			InTitle: strings.Contains(titleText, " "+t+" "),
+			InH1:    strings.Contains(h1Text, " "+t+" "),
+		})
+	}
+
+	keywords := []models.Keyword{}
+	for n := 1; n <= MaxWords; n++ {
+		top := sized[n]
+		sort.Slice(top, func(i, j int) bool {
+			if top[i].Count != top[j].Count {
+				return top[i].Count > top[j].Count
+			}
+
+			return top[i].Term < top[j].Term
+		})
+
+		if len(top) > MaxTerms {
+			top = top[:MaxTerms]
+		}
+
+		keywords = append(keywords, top...)
+	}
+
+	primary := -1
+	for i, k := range keywords {
+		if primary == -1 || ranksHigher(k, keywords[primary]) {
+			primary = i
+		}
+	}
+
+	if primary >= 0 {
+		keywords[primary].Primary = true
+	}
+
+	return keywords
+}
+
+// SiteTerms aggregates the term counts of all the pages in a crawl.
+type SiteTerms struct {
+	terms map[string]*models.SiteKeyword
+}
+
+func NewSiteTerms() *SiteTerms {
+	return &SiteTerms{
+		terms: make(map[string]*models.SiteKeyword),
+	}
+}
+
+// Add adds the term counts of a page to the site terms. The terms of the page that
+// are in the title or the H1 are counted once per page.
+func (s *SiteTerms) Add(terms map[string]int, title, h1 string) {
+	for t, c := range terms {
+		k, ok := s.terms[t]
+		if !ok {
+			k = &models.SiteKeyword{Term: t, Words: termWords(t)}
+			s.terms[t] = k
+		}
+
+		k.Count += c
+		k.Pages++
+	}
+
+	for t := range headingTerms(title) {
+		if k, ok := s.terms[t]; ok && terms[t] > 0 {
+			k.Titles++
+		}
+	}
+
+	for t := range headingTerms(h1) {
+		if k, ok := s.terms[t]; ok && terms[t] > 0 {
+			k.H1s++
+		}
+	}
+}
+
+// Top returns up to limit terms of each size, sorted by the number of pages
+// with the term and the number of times it appears in the crawl.
+func (s *SiteTerms) Top(limit int) []models.SiteKeyword {
+	sized := make([][]models.SiteKeyword, MaxWords+1)
+	for _, k := range s.terms {
+		sized[k.Words] = append(sized[k.Words], *k)
+	}
+
+	keywords := []models.SiteKeyword{}
+	for n := 1; n <= MaxWords; n++ {
+		top := sized[n]
+		sort.Slice(top, func(i, j int) bool {
+			if top[i].Pages != top[j].Pages {
+				return top[i].Pages > top[j].Pages
+			}
+
+			if top[i].Count != top[j].Count {
+				return top[i].Count > top[j].Count
+			}
+
+			return top[i].Term < top[j].Term
+		})
+
+		if len(top) > limit {
+			top = top[:limit]
+		}
+
+		keywords = append(keywords, top...)
+	}
+
+	return keywords
+}
+
+// Returns the set of n-grams of up to MaxWords words in the text.
+func headingTerms(s string) map[string]bool {
+	words := Words(s)
+	terms := map[string]bool{}
+	for n := 1; n <= MaxWords; n++ {
+		for i := 0; i+n <= len(words); i++ {
+			terms[strings.Join(words[i:i+n], " ")] = true
+		}
+	}
+
+	return terms
+}
+
+// Returns the number of words in the term.
+func termWords(t string) int {
+	return strings.Count(t, " ") + 1
+}
+
+// Returns true if the term a ranks higher than b as the primary term. Terms in the title
+// or the H1 always rank higher than the ones that are only in the content.
+func ranksHigher(a, b models.Keyword) bool {
+	aHead := a.InTitle || a.InH1
+	bHead := b.InTitle || b.InH1
+	if aHead != bHead {
+		return aHead
+	}
+
+	return a.Count*a.Words > b.Count*b.Words
+}
+
+// Returns true if the word can be part of a term. Stopwords, numbers and single
+// characters are not.
+func isTermWord(w string, stop map[string]bool) bool {
+	if stop[w] || utf8.RuneCountInString(w) < 2 {
+		return false
+	}
+
+	for _, r := range w {
+		if !unicode.IsDigit(r) {
+			return true
+		}
+	}
+
+	return false
+}
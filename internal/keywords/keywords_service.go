@@ -0,0 +1,63 @@
+package keywords
+
+import (
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// SiteKeywordsLimit is the maximum number of terms of each size in the site keywords.
+const SiteKeywordsLimit = 100
+
+type KeywordsStore interface {
+	FindSiteKeywords(crawlId int64, words int, limit int) []models.SiteKeyword
+	FindPrimaryKeywordPages(crawlId int64) []models.KeywordPage
+}
+
+type Service struct {
+	store KeywordsStore
+}
+
+// Cluster is a group of pages sharing the same primary term, which may be competing
+// for the same search queries.
+type Cluster struct {
+	Term        string
+	PageReports []models.PageReport
+}
+
+func NewService(s KeywordsStore) *Service {
+	return &Service{
+		store: s,
+	}
+}
+
+// GetSiteKeywords returns the terms with the given number of words that are in more
+// indexable pages of the crawl. Words defaults to 1 if it is out of range.
+func (s *Service) GetSiteKeywords(crawlId int64, words int) []models.SiteKeyword {
+	if words < 1 || words > MaxWords {
+		words = 1
+	}
+
+	return s.store.FindSiteKeywords(crawlId, words, SiteKeywordsLimit)
+}
+
+// GetCannibalization returns the clusters of pages that share the same primary term.
+// Only clusters with two or more pages are returned.
+func (s *Service) GetCannibalization(crawlId int64) []Cluster {
+	clusters := []Cluster{}
+	for _, p := range s.store.FindPrimaryKeywordPages(crawlId) {
+		if len(clusters) == 0 || clusters[len(clusters)-1].Term != p.Term {
+			clusters = append(clusters, Cluster{Term: p.Term})
+		}
+
+		c := &clusters[len(clusters)-1]
+		c.PageReports = append(c.PageReports, p.PageReport)
+	}
+
+	result := []Cluster{}
+	for _, c := range clusters {
+		if len(c.PageReports) > 1 {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
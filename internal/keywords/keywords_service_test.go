@@ -0,0 +1,64 @@
+package keywords_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/keywords"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+const crawlId = 1
+
+type storage struct {
+	words int
+	limit int
+}
+
+func (s *storage) FindSiteKeywords(crawlId int64, words int, limit int) []models.SiteKeyword {
+	s.words = words
+	s.limit = limit
+
+	return []models.SiteKeyword{}
+}
+
+func (s *storage) FindPrimaryKeywordPages(crawlId int64) []models.KeywordPage {
+	return []models.KeywordPage{
+		{Term: "running shoes", PageReport: models.PageReport{Id: 1}},
+		{Term: "running shoes", PageReport: models.PageReport{Id: 2}},
+		{Term: "trail", PageReport: models.PageReport{Id: 3}},
+		{Term: "walking shoes", PageReport: models.PageReport{Id: 4}},
+		{Term: "walking shoes", PageReport: models.PageReport{Id: 5}},
+		{Term: "walking shoes", PageReport: models.PageReport{Id: 6}},
+	}
+}
+
+func TestGetSiteKeywords(t *testing.T) {
+	s := &storage{}
+	service := keywords.NewService(s)
+
+	service.GetSiteKeywords(crawlId, 5)
+	if s.words != 1 {
+		t.Errorf("GetSiteKeywords words: %d != 1", s.words)
+	}
+
+	if s.limit != keywords.SiteKeywordsLimit {
+		t.Errorf("GetSiteKeywords limit: %d != %d", s.limit, keywords.SiteKeywordsLimit)
+	}
+}
+
+func TestGetCannibalization(t *testing.T) {
+	service := keywords.NewService(&storage{})
+
+	clusters := service.GetCannibalization(crawlId)
+	if len(clusters) != 2 {
+		t.Fatalf("Clusters: %d != 2", len(clusters))
+	}
+
+	if clusters[0].Term != "running shoes" || len(clusters[0].PageReports) != 2 {
+		t.Errorf("Cluster 0: %s with %d pages", clusters[0].Term, len(clusters[0].PageReports))
+	}
+
+	if clusters[1].Term != "walking shoes" || len(clusters[1].PageReports) != 3 {
+		t.Errorf("Cluster 1: %s with %d pages", clusters[1].Term, len(clusters[1].PageReports))
+	}
+}
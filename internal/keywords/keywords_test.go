@@ -0,0 +1,94 @@
+package keywords_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/keywords"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+func TestStopwords(t *testing.T) {
+	table := []struct {
+		lang string
+		word string
+	}{
+		{"en", "the"},
+		{"en-GB", "the"},
+		{"es-ES", "para"},
+		{"", "the"},
+		{"xx", "the"},
+	}
+
+	for _, tt := range table {
+		if !keywords.Stopwords(tt.lang)[tt.word] {
+			t.Errorf("Stopwords %s: %s should be a stopword", tt.lang, tt.word)
+		}
+	}
+}
+
+func TestExtract(t *testing.T) {
+	words := keywords.Words(`Running shoes for the trail. The best running shoes are light.
+		Trail running shoes have grip. Shoes, shoes and 2023 running. Trail running shoes for everyone.`)
+
+	kws := keywords.Extract(keywords.Count(words, "en"), "Trail Running Shoes | Shop", "Running shoes")
+
+	top := map[int]string{}
+	var primary string
+	for _, k := range kws {
+		if _, ok := top[k.Words]; !ok {
+			top[k.Words] = k.Term
+		}
+
+		if k.Primary {
+			primary = k.Term
+		}
+
+		if k.Term == "the" || k.Term == "2023" || k.Term == "for the" {
+			t.Errorf("Extract: %s should not be a term", k.Term)
+		}
+	}
+
+	want := map[int]string{
+		1: "shoes",
+		2: "running shoes",
+		3: "trail running shoes",
+	}
+
+	for n, term := range want {
+		if top[n] != term {
+			t.Errorf("Extract %d words: %s != %s", n, top[n], term)
+		}
+	}
+
+	if primary != "running shoes" {
+		t.Errorf("Extract primary: %s != running shoes", primary)
+	}
+}
+
+func TestSiteTerms(t *testing.T) {
+	site := keywords.NewSiteTerms()
+	site.Add(keywords.Count(keywords.Words("Running shoes. Running shoes for the trail."), "en"), "Running shoes", "")
+	site.Add(keywords.Count(keywords.Words("Trail shoes and trail maps."), "en"), "Trail maps", "Trail")
+
+	want := map[string]models.SiteKeyword{
+		"shoes":         {Term: "shoes", Words: 1, Count: 3, Pages: 2, Titles: 1},
+		"trail":         {Term: "trail", Words: 1, Count: 3, Pages: 2, Titles: 1, H1s: 1},
+		"running shoes": {Term: "running shoes", Words: 2, Count: 2, Pages: 1, Titles: 1},
+	}
+
+	top := site.Top(2)
+	got := map[string]models.SiteKeyword{}
+	for _, k := range top {
+		got[k.Term] = k
+	}
+
+	for term, k := range want {
+		if got[term] != k {
+			t.Errorf("SiteTerms %s: %+v != %+v", term, got[term], k)
+		}
+	}
+
+	if len(top) != 3 {
+		t.Errorf("SiteTerms top: %d != 3", len(top))
+	}
+}
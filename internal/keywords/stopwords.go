@@ -0,0 +1,68 @@
+package keywords
+
+import "strings"
+
+// Stopwords by language code. They are common words that don't say anything about the
+// topic of a page, so they are left out of the terms.
+var stopwords = map[string]map[string]bool{
+	"en": newStopwords(`a about above after again against all am an and any are as at be because been
+		before being below between both but by can could did do does doing down during each few for
+		from further had has have having he her here hers herself him himself his how i if in into is
+		it its itself just me more most my myself no nor not now of off on once only or other our ours
+		ourselves out over own same she should so some such than that the their theirs them themselves
+		then there these they this those through to too under until up very was we were what when where
+		which while who whom why will with would you your yours yourself yourselves also get got may
+		might must shall us via etc`),
+	"es": newStopwords(`a al algo algunas algunos ante antes como con contra cual cuando de del desde
+		donde durante e el ella ellas ellos en entre era eran es esa esas ese eso esos esta estaba estas
+		este esto estos fue fueron ha han hasta hay la las le les lo los mas me mi mis mucho muy más nada
+		ni no nos nosotros o os otra otras otro otros para pero poco por porque que quien se sea ser si
+		sin sobre son su sus también tan te tiene tienen todo todos tu tus un una uno unos y ya yo él`),
+	"fr": newStopwords(`a au aux avec ce ces cette dans de des du elle elles en est et eu il ils je
+		la le les leur leurs lui ma mais me mes moi mon ne nos notre nous on ou où par pas pour qu que
+		qui sa se ses son sont sur ta te tes toi ton tu un une vos votre vous y été être à ça plus très`),
+	"de": newStopwords(`aber als am an auch auf aus bei bin bis bist da dann das dass dein deine dem
+		den der des dich die dir doch du durch ein eine einem einen einer eines er es für hat hatte ich
+		ihr ihre im in ist ja kann kein keine mich mir mit muss nach nicht noch nur ob oder ohne sein
+		sich sie sind so über um und uns unser unter vom von vor war waren was weil wenn wer wie wir
+		wird zu zum zur`),
+	"it": newStopwords(`a ad al alla alle anche che chi ci come con da dal dalla dei del della delle
+		di e ed era gli ha hanno i il in io la le lei lo loro lui ma mi ne nel nella noi non o per più
+		quella quello questa questo se si sono su sua suo tra tu un una uno voi è`),
+	"pt": newStopwords(`a ao aos as com como da das de do dos e ela elas ele eles em entre era essa
+		esse esta este eu foi for há isso isto já mais mas me mesmo meu minha muito na nas nem no nos
+		não o os ou para pela pelo por qual quando que se sem ser seu seus sua suas também te tem um
+		uma você à é`),
+	"nl": newStopwords(`aan al als bij dan dat de der deze die dit doen door een en er had heb hebben
+		heeft het hij hoe hun ik in is je kan maar me met mij naar niet nog of om ook op over te tot
+		uit van voor was wat we wel wie wij worden wordt zal ze zich zij zijn zo`),
+}
+
+// Default language used when the page language has no stopwords.
+const defaultLang = "en"
+
+// Returns a set with the words in the space separated list.
+func newStopwords(list string) map[string]bool {
+	m := map[string]bool{}
+	for _, w := range strings.Fields(list) {
+		m[w] = true
+	}
+
+	return m
+}
+
+// Returns the stopwords for the language code. Only the primary language subtag is used,
+// so "en-GB" returns the English stopwords. The English stopwords are returned if there
+// are no stopwords for the language.
+func Stopwords(lang string) map[string]bool {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(lang, "-_,"); i >= 0 {
+		lang = lang[:i]
+	}
+
+	if s, ok := stopwords[lang]; ok {
+		return s
+	}
+
+	return stopwords[defaultLang]
+}
@@ -0,0 +1,13 @@
+package models
+
+// Accessibility contains the number of elements in the page that fail the most
+// common WCAG accessibility checks.
+type Accessibility struct {
+	InputsWithoutLabel  int  // Form fields without a label or accessible name
+	ButtonsWithoutName  int  // Buttons without an accessible name
+	LinksWithoutName    int  // Links without an accessible name
+	PositiveTabindex    int  // Elements with a tabindex greater than zero
+	IframesWithoutTitle int  // Iframes without a title
+	ImagesWithoutAlt    int  // Images without alt attribute that are not decorative or hidden
+	MissingLang         bool // The html element has no lang attribute
+}
@@ -0,0 +1,15 @@
+package models
+
+// CacheHeaders contains the values of the HTTP caching headers of a response.
+// MaxAge is the freshness lifetime in seconds computed from the Cache-Control and Expires
+// headers. It is 0 if the response can't be reused without revalidation and -1 if
+// the headers don't specify it.
+type CacheHeaders struct {
+	CacheControl string
+	Expires      string
+	Age          string
+	ETag         string
+	LastModified string
+	SetCookie    bool
+	MaxAge       int64
+}
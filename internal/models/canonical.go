@@ -0,0 +1,13 @@
+package models
+
+// Sources where a canonical URL can be declared.
+const (
+	CanonicalSourceHTML   = "html"   // <link rel="canonical"> tag
+	CanonicalSourceHeader = "header" // Link HTTP header
+)
+
+// Canonical is a canonical URL declared by a page, along with the source it was found in.
+type Canonical struct {
+	URL    string
+	Source string
+}
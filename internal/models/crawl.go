@@ -17,6 +17,7 @@ type Crawl struct {
 	CriticalIssues        int
 	AlertIssues           int
 	WarningIssues         int
+	AccessibilityIssues   int
 	BlockedByRobotstxt    int // URLs blocked by robots.txt
 	Noindex               int // URLS with noindex attribute
 	SitemapExists         bool
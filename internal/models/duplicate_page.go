@@ -0,0 +1,11 @@
+package models
+
+// DuplicatePage is a page that is a candidate for the duplicate content clusters.
+// Cluster is the number of the page's duplicate content cluster in its crawl, or 0 if the
+// page is not in a cluster. Canonical is true if the page has been selected as the canonical
+// URL of its cluster.
+type DuplicatePage struct {
+	PageReport PageReport
+	Cluster    int
+	Canonical  bool
+}
@@ -0,0 +1,6 @@
+package models
+
+type ExternalLinkStatus struct {
+	URL        string
+	StatusCode int
+}
@@ -0,0 +1,24 @@
+package models
+
+// Extractor types.
+const (
+	ExtractorXPath = "xpath"
+	ExtractorCSS   = "css"
+	ExtractorRegex = "regex"
+)
+
+// Extractor is a project's custom extraction rule. The Expression is an XPath expression,
+// a CSS selector or a regular expression depending on the Type.
+type Extractor struct {
+	Id         int64
+	ProjectId  int64
+	Name       string
+	Type       string
+	Expression string
+}
+
+// Extraction is the value found by an extractor in a page.
+type Extraction struct {
+	Name  string
+	Value string
+}
@@ -0,0 +1,16 @@
+package models
+
+// HeadElements contains the problems found in the HTML head that may cause browsers and
+// search engines to ignore some of its tags.
+type HeadElements struct {
+	BreakingElement string   // The first element that closes the head early, empty if the head is valid
+	TagsAfterBreak  []string // The SEO tags found in the head after the breaking element
+	DuplicateIds    []string // The id attribute values used by more than one element
+	Titles          int      // The number of title tags in the page
+	Canonicals      int      // The number of canonical link tags in the page
+}
+
+// Returns true if none of the head elements fields are set.
+func (h HeadElements) IsEmpty() bool {
+	return h.BreakingElement == "" && len(h.DuplicateIds) == 0 && h.Titles == 0 && h.Canonicals == 0
+}
@@ -0,0 +1,7 @@
+package models
+
+type Heading struct {
+	Level      int
+	Text       string
+	SkipsLevel bool // True if one or more levels were skipped from the previous heading
+}
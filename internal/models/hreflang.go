@@ -4,3 +4,13 @@ type Hreflang struct {
 	URL  string
 	Lang string
 }
+
+// HreflangAnnotation is an hreflang link along with the URL, language and id of the page
+// that declares it.
+type HreflangAnnotation struct {
+	PageReportId int64
+	URL          string
+	Lang         string
+	ToURL        string
+	ToLang       string
+}
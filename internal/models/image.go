@@ -1,6 +1,11 @@
 package models
 
 type Image struct {
-	URL string
-	Alt string
+	URL    string
+	Alt    string
+	Width  string
+	Height string
+	SrcSet bool
+
+	Size int // Size in bytes of the crawled image, only set when loading a stored PageReport.
 }
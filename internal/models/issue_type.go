@@ -0,0 +1,14 @@
+package models
+
+// IssueType describes a type of issue reported by the issue reporters.
+// The Code is stored in the issue_types table, and the Title and Description are the
+// issue's English title and description. The Category is the translation key of the
+// category name.
+type IssueType struct {
+	Id          int
+	Code        string
+	Priority    int
+	Category    string
+	Title       string
+	Description string
+}
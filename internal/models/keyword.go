@@ -0,0 +1,28 @@
+package models
+
+// Keyword is one of the most frequent terms in the main content of a page.
+// Terms can be a single word or an n-gram of two or three words.
+type Keyword struct {
+	Term    string
+	Words   int  // The number of words in the term
+	Count   int  // The number of times the term appears in the main content
+	InTitle bool // True if the term is in the page title
+	InH1    bool // True if the term is in the page H1
+	Primary bool // True if this is the page's primary term
+}
+
+// SiteKeyword is a term with its frequency in all the pages of a crawl.
+type SiteKeyword struct {
+	Term   string
+	Words  int
+	Count  int // The number of times the term appears in the crawl
+	Pages  int // The number of indexable pages with the term in their content
+	Titles int // The number of pages with the term in the title
+	H1s    int // The number of pages with the term in the H1
+}
+
+// KeywordPage is a page with the term that is the primary term of the page.
+type KeywordPage struct {
+	Term       string
+	PageReport PageReport
+}
@@ -4,13 +4,28 @@ import (
 	"net/url"
 )
 
+// Positions of a link in the page layout.
+const (
+	LinkPositionNav    = "nav"
+	LinkPositionHeader = "header"
+	LinkPositionFooter = "footer"
+	LinkPositionAside  = "aside"
+	LinkPositionMain   = "main"
+)
+
 type Link struct {
-	URL       string
-	ParsedURL *url.URL
-	Rel       string
-	Text      string
-	External  bool
-	NoFollow  bool
-	Sponsored bool
-	UGC       bool
+	URL           string
+	ParsedURL     *url.URL
+	Rel           string
+	Text          string
+	External      bool
+	NoFollow      bool
+	Sponsored     bool
+	UGC           bool
+	Position      string
+	Title         string
+	BlankNoOpener bool // target="_blank" without rel="noopener" or rel="noreferrer"
+	Image         bool // The link wraps an image, its alt text is used as anchor text
+
+	StatusCode int
 }
@@ -0,0 +1,19 @@
+package models
+
+// Link relation types.
+const (
+	RelNext      = "next"
+	RelPrev      = "prev"
+	RelAmpHTML   = "amphtml"
+	RelAlternate = "alternate"
+)
+
+// LinkRelation is a link element relating the page to other URLs, such as the next and previous
+// pages of a paginated series, the AMP version of the page or its mobile alternate.
+// Media is only set in mobile alternates.
+// ex. <link rel="alternate" media="only screen and (max-width: 640px)" href="https://m.example.com/">
+type LinkRelation struct {
+	Rel   string
+	URL   string
+	Media string
+}
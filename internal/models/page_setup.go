@@ -0,0 +1,12 @@
+package models
+
+// PageSetup contains the tags used to set up the page in browsers and mobile devices,
+// as well as the charset declared in the HTML and in the Content-Type HTTP header.
+type PageSetup struct {
+	Viewport       string
+	MetaCharset    string // The charset declared in the HTML meta tags
+	HeaderCharset  string // The charset declared in the Content-Type HTTP header
+	Favicon        string // The favicon absolute URL, or the favicon.ico in the root if there's no favicon link
+	AppleTouchIcon string // The apple-touch-icon absolute URL
+	ThemeColor     string
+}
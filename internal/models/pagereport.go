@@ -20,12 +20,14 @@ type PageReport struct {
 	Noindex            bool
 	Nofollow           bool
 	Canonical          string
+	Canonicals         []Canonical
 	H1                 string
 	H2                 string
 	Links              []Link
 	ExternalLinks      []Link
 	Words              int
 	Hreflangs          []Hreflang
+	LinkRelations      []LinkRelation
 	Size               int
 	Images             []Image
 	Scripts            []string
@@ -39,4 +41,26 @@ type PageReport struct {
 	InSitemap          bool
 	InternalLinks      []InternalLink
 	ValidLang          bool
+	RobotsDirectives   []RobotsDirective
+	RobotsConflict     bool
+	StructuredData     []StructuredData
+	SocialTags         SocialTags
+	PageSetup          PageSetup
+	HeadElements       HeadElements
+	Accessibility      Accessibility
+	Keywords           []Keyword
+	Terms              map[string]int
+	PDF                PDF
+	SecurityHeaders    SecurityHeaders
+	CacheHeaders       CacheHeaders
+	Headings           []Heading
+	ContentHash        string
+	SimHash            uint64
+	TextRatio          float64
+	Sentences          int
+	AvgSentenceLength  float64
+	Readability        float64
+	ValidReadability   bool
+	Extractions        []Extraction
+	CustomIssues       []string
 }
@@ -0,0 +1,6 @@
+package models
+
+// PDF contains the properties of a PDF document that are not part of the PageReport.
+type PDF struct {
+	Pages int // The number of pages in the document
+}
@@ -18,4 +18,8 @@ type Project struct {
 	BasicAuth       bool
 	AuthUser        string
 	AuthPass        string
+
+	CheckExternalLinks bool
+	CheckReadability   bool
+	Thresholds         Thresholds
 }
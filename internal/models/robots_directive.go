@@ -0,0 +1,10 @@
+package models
+
+// RobotsDirective is a single directive found in a robots meta tag or
+// in a X-Robots-Tag header, ex. "googlebot: max-snippet:50".
+type RobotsDirective struct {
+	Source string // Either "html" or "header"
+	Bot    string // Lowercase bot name or empty if it applies to all bots
+	Name   string // Lowercase directive name, ex. "noindex"
+	Value  string // Directive value, ex. "50" in "max-snippet:50"
+}
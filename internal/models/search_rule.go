@@ -0,0 +1,32 @@
+package models
+
+// Search rule types.
+const (
+	SearchSubstring = "substring"
+	SearchRegex     = "regex"
+)
+
+// Search rule modes.
+const (
+	SearchContains    = "contains"
+	SearchNotContains = "not_contains"
+)
+
+// Search rule targets.
+const (
+	SearchHTML = "html"
+	SearchText = "text"
+)
+
+// SearchRule is a project's custom content search. Pages where the Pattern is found, or is not found
+// if the Mode is SearchNotContains, are reported with an issue named after the rule.
+// The Pattern is searched in the raw HTML or in the visible text of the page depending on the Target.
+type SearchRule struct {
+	Id        int64
+	ProjectId int64
+	Name      string
+	Pattern   string
+	Type      string
+	Mode      string
+	Target    string
+}
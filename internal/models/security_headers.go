@@ -0,0 +1,11 @@
+package models
+
+// SecurityHeaders contains the values of the security related HTTP response headers of a page.
+type SecurityHeaders struct {
+	StrictTransportSecurity string
+	ContentSecurityPolicy   string
+	XFrameOptions           string
+	XContentTypeOptions     string
+	ReferrerPolicy          string
+	PermissionsPolicy       string
+}
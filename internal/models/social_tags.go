@@ -0,0 +1,16 @@
+package models
+
+// SocialTags contains the Open Graph and Twitter Card meta tags of a page.
+type SocialTags struct {
+	OGTitle            string
+	OGDescription      string
+	OGImage            string // The og:image as it is defined in the meta tag
+	OGImageURL         string // The og:image absolute URL
+	OGURL              string
+	OGType             string
+	OGSiteName         string
+	TwitterCard        string
+	TwitterTitle       string
+	TwitterDescription string
+	TwitterImage       string
+}
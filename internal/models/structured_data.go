@@ -0,0 +1,8 @@
+package models
+
+type StructuredData struct {
+	Format     string   // Either "json-ld", "microdata" or "rdfa"
+	Type       string   // Schema type without the vocabulary, ex. "Product"
+	Properties []string // Names of the item's top level properties
+	Valid      bool     // False if the markup could not be parsed or its type can't be resolved
+}
@@ -0,0 +1,13 @@
+package models
+
+// SuppressionRule is a project's rule to suppress the issues of the IssueType, which contains
+// the issue type code. If the URLPattern is set only the issues of the pages with a URL
+// matching the pattern are suppressed. The pattern matches any part of the URL and
+// the "*" character can be used as a wildcard.
+// Suppressed issues are stored but they are not included in the issue counts.
+type SuppressionRule struct {
+	Id         int64
+	ProjectId  int64
+	IssueType  string
+	URLPattern string
+}
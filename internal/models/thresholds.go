@@ -0,0 +1,31 @@
+package models
+
+// MinDuplicateThreshold is the lowest similarity percentage allowed for the duplicate threshold.
+// Lower values would cluster pages that only share their boilerplate content.
+const MinDuplicateThreshold = 80
+
+// Thresholds contains the project's limits used by the issue reporters.
+type Thresholds struct {
+	TitleMinLength       int // Titles shorter than this are reported as short
+	TitleMaxLength       int // Titles longer than this are reported as long
+	DescriptionMinLength int // Descriptions shorter than this are reported as short
+	DescriptionMaxLength int // Descriptions longer than this are reported as long
+	MaxLinks             int // Pages with more internal links than this are reported
+	MinWords             int // Pages with fewer words than this are reported as little content
+	MaxImageSize         int // Images larger than this size in KB are reported as oversized
+	DuplicateThreshold   int // Pages whose main content is at least this % similar are near duplicates
+}
+
+// NewThresholds returns the default Thresholds.
+func NewThresholds() *Thresholds {
+	return &Thresholds{
+		TitleMinLength:       20,
+		TitleMaxLength:       60,
+		DescriptionMinLength: 80,
+		DescriptionMaxLength: 160,
+		MaxLinks:             100,
+		MinWords:             200,
+		MaxImageSize:         100,
+		DuplicateThreshold:   90,
+	}
+}
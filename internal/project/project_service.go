@@ -3,9 +3,12 @@ package project
 import (
 	"errors"
 	"net/url"
+	"strings"
 
 	"github.com/stjudewashere/seonaut/internal/cache_manager"
+	"github.com/stjudewashere/seonaut/internal/html_parser"
 	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
 )
 
 type Storage interface {
@@ -14,6 +17,15 @@ type Storage interface {
 	GetLastCrawl(*models.Project) models.Crawl
 	UpdateProject(p *models.Project) error
 	FindProjectById(id int, uid int) (models.Project, error)
+	FindExtractors(pid int64) []models.Extractor
+	SaveExtractor(e *models.Extractor) error
+	DeleteExtractor(id, pid int64)
+	FindSearchRules(pid int64) []models.SearchRule
+	SaveSearchRule(r *models.SearchRule) error
+	DeleteSearchRule(id, pid int64)
+	FindSuppressionRules(pid int64) []models.SuppressionRule
+	SaveSuppressionRule(r *models.SuppressionRule) error
+	DeleteSuppressionRule(id, pid int64)
 }
 
 type Service struct {
@@ -73,3 +85,79 @@ func (s *Service) DeleteProject(p *models.Project) {
 func (s *Service) UpdateProject(p *models.Project) error {
 	return s.storage.UpdateProject(p)
 }
+
+// Returns the project's last crawl.
+func (s *Service) GetLastCrawl(p *models.Project) models.Crawl {
+	return s.storage.GetLastCrawl(p)
+}
+
+// Returns the project's custom extractors.
+func (s *Service) GetExtractors(p *models.Project) []models.Extractor {
+	return s.storage.FindExtractors(p.Id)
+}
+
+// Validates and stores a new custom extractor for the project.
+func (s *Service) AddExtractor(p *models.Project, e *models.Extractor) error {
+	e.ProjectId = p.Id
+	if err := html_parser.ValidateExtractor(*e); err != nil {
+		return err
+	}
+
+	for _, x := range s.storage.FindExtractors(p.Id) {
+		if strings.EqualFold(x.Name, e.Name) {
+			return errors.New("an extractor with this name already exists")
+		}
+	}
+
+	return s.storage.SaveExtractor(e)
+}
+
+// Deletes one of the project's custom extractors.
+func (s *Service) DeleteExtractor(p *models.Project, id int64) {
+	s.storage.DeleteExtractor(id, p.Id)
+}
+
+// Returns the project's custom search rules.
+func (s *Service) GetSearchRules(p *models.Project) []models.SearchRule {
+	return s.storage.FindSearchRules(p.Id)
+}
+
+// Validates and stores a new custom search rule for the project.
+func (s *Service) AddSearchRule(p *models.Project, r *models.SearchRule) error {
+	r.ProjectId = p.Id
+	if err := html_parser.ValidateSearchRule(*r); err != nil {
+		return err
+	}
+
+	return s.storage.SaveSearchRule(r)
+}
+
+// Deletes one of the project's custom search rules.
+func (s *Service) DeleteSearchRule(p *models.Project, id int64) {
+	s.storage.DeleteSearchRule(id, p.Id)
+}
+
+// Returns the project's issue suppression rules.
+func (s *Service) GetSuppressionRules(p *models.Project) []models.SuppressionRule {
+	return s.storage.FindSuppressionRules(p.Id)
+}
+
+// Validates and stores a new issue suppression rule for the project.
+// The rule's issue type must be one of the registered issue types.
+func (s *Service) AddSuppressionRule(p *models.Project, r *models.SuppressionRule) error {
+	r.ProjectId = p.Id
+	if _, ok := reporter_errors.GetIssueTypeByCode(r.IssueType); !ok {
+		return errors.New("suppression rule issue type not supported")
+	}
+
+	if len(r.URLPattern) > 1024 {
+		return errors.New("suppression rule URL pattern is too long")
+	}
+
+	return s.storage.SaveSuppressionRule(r)
+}
+
+// Deletes one of the project's issue suppression rules.
+func (s *Service) DeleteSuppressionRule(p *models.Project, id int64) {
+	s.storage.DeleteSuppressionRule(id, p.Id)
+}
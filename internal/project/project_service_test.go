@@ -2,6 +2,7 @@ package project_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stjudewashere/seonaut/internal/cache_manager"
@@ -39,6 +40,28 @@ func (s *storage) FindProjectById(id, uid int) (models.Project, error) {
 	return p, nil
 }
 
+func (s *storage) FindExtractors(pid int64) []models.Extractor {
+	return []models.Extractor{{Name: "Canonical"}}
+}
+func (s *storage) SaveExtractor(e *models.Extractor) error {
+	return nil
+}
+func (s *storage) DeleteExtractor(id, pid int64) {}
+func (s *storage) FindSearchRules(pid int64) []models.SearchRule {
+	return []models.SearchRule{}
+}
+func (s *storage) SaveSearchRule(r *models.SearchRule) error {
+	return nil
+}
+func (s *storage) DeleteSearchRule(id, pid int64) {}
+func (s *storage) FindSuppressionRules(pid int64) []models.SuppressionRule {
+	return []models.SuppressionRule{}
+}
+func (s *storage) SaveSuppressionRule(r *models.SuppressionRule) error {
+	return nil
+}
+func (s *storage) DeleteSuppressionRule(id, pid int64) {}
+
 var service = project.NewService(&storage{}, cache_manager.New())
 
 func TestFindProjectById(t *testing.T) {
@@ -80,3 +103,94 @@ func TestSaveProject(t *testing.T) {
 		t.Error("TestSaveProject: not supported scheme should return error")
 	}
 }
+
+func TestAddExtractor(t *testing.T) {
+	p := &models.Project{Id: gid}
+
+	valid := []models.Extractor{
+		{Name: "Price", Type: models.ExtractorXPath, Expression: "//span[@itemprop='price']"},
+		{Name: "Author", Type: models.ExtractorCSS, Expression: "div.post > a[rel=author]"},
+		{Name: "GTM", Type: models.ExtractorRegex, Expression: `GTM-[A-Z0-9]+`},
+	}
+
+	for _, e := range valid {
+		if err := service.AddExtractor(p, &e); err != nil {
+			t.Errorf("TestAddExtractor: %s should not return error: %v", e.Name, err)
+		}
+	}
+
+	invalid := []models.Extractor{
+		{Name: "", Type: models.ExtractorXPath, Expression: "//title"},
+		{Name: "XPath", Type: models.ExtractorXPath, Expression: "//span[@"},
+		{Name: "CSS", Type: models.ExtractorCSS, Expression: "div.post > a[rel="},
+		{Name: "Regex", Type: models.ExtractorRegex, Expression: "GTM-("},
+		{Name: "canonical", Type: models.ExtractorXPath, Expression: "//link[@rel='canonical']/@href"},
+		{Name: "Type", Type: "json", Expression: "$.price"},
+	}
+
+	for _, e := range invalid {
+		if err := service.AddExtractor(p, &e); err == nil {
+			t.Errorf("TestAddExtractor: %s should return error", e.Name)
+		}
+	}
+}
+
+func TestAddSearchRule(t *testing.T) {
+	p := &models.Project{Id: 1}
+
+	valid := []models.SearchRule{
+		{Name: "Analytics", Pattern: "gtag(", Type: models.SearchSubstring, Mode: models.SearchNotContains, Target: models.SearchHTML},
+		{Name: "Lorem", Pattern: `(?i)lorem\s+ipsum`, Type: models.SearchRegex, Mode: models.SearchContains, Target: models.SearchText},
+	}
+
+	for _, r := range valid {
+		if err := service.AddSearchRule(p, &r); err != nil {
+			t.Errorf("TestAddSearchRule: %s should not return error: %v", r.Name, err)
+		}
+	}
+
+	invalid := []models.SearchRule{
+		{Name: "", Pattern: "gtag(", Type: models.SearchSubstring, Mode: models.SearchContains, Target: models.SearchHTML},
+		{Name: "Empty", Pattern: "", Type: models.SearchSubstring, Mode: models.SearchContains, Target: models.SearchHTML},
+		{Name: "Regex", Pattern: "gtag(", Type: models.SearchRegex, Mode: models.SearchContains, Target: models.SearchHTML},
+		{Name: "Mode", Pattern: "gtag", Type: models.SearchSubstring, Mode: "equals", Target: models.SearchHTML},
+		{Name: "Target", Pattern: "gtag", Type: models.SearchSubstring, Mode: models.SearchContains, Target: "headers"},
+	}
+
+	for _, r := range invalid {
+		if err := service.AddSearchRule(p, &r); err == nil {
+			t.Errorf("TestAddSearchRule: %s should return error", r.Name)
+		}
+	}
+}
+
+func TestAddSuppressionRule(t *testing.T) {
+	p := &models.Project{Id: 1}
+
+	valid := []models.SuppressionRule{
+		{IssueType: "ERROR_NO_INDEXABLE", URLPattern: "/filter/"},
+		{IssueType: "ERROR_EXTERNAL_WITHOUT_NOFOLLOW", URLPattern: ""},
+	}
+
+	for _, r := range valid {
+		if err := service.AddSuppressionRule(p, &r); err != nil {
+			t.Errorf("TestAddSuppressionRule: %s should not return error: %v", r.IssueType, err)
+		}
+
+		if r.ProjectId != p.Id {
+			t.Errorf("TestAddSuppressionRule: ProjectId %d != %d", r.ProjectId, p.Id)
+		}
+	}
+
+	invalid := []models.SuppressionRule{
+		{IssueType: "", URLPattern: "/filter/"},
+		{IssueType: "NOT_AN_ISSUE", URLPattern: ""},
+		{IssueType: "ERROR_NO_INDEXABLE", URLPattern: strings.Repeat("a", 1025)},
+	}
+
+	for _, r := range invalid {
+		if err := service.AddSuppressionRule(p, &r); err == nil {
+			t.Errorf("TestAddSuppressionRule: %s should return error", r.IssueType)
+		}
+	}
+}
@@ -70,6 +70,23 @@ func (r *Renderer) trans(s string) string {
 	return fmt.Sprintf("%v", t)
 }
 
+// HasTranslation returns true if the translations map contains the key.
+func (r *Renderer) HasTranslation(key string) bool {
+	_, ok := r.translationMap[key]
+
+	return ok
+}
+
+// AddTranslations adds the translations to the translations map. Keys already loaded
+// from the translations file are kept, so the file can override them.
+func (r *Renderer) AddTranslations(t map[string]string) {
+	for k, v := range t {
+		if _, ok := r.translationMap[k]; !ok {
+			r.translationMap[k] = v
+		}
+	}
+}
+
 // Returns the difference between the start time and the end time
 func (r *Renderer) totalTime(start, end time.Time) time.Duration {
 	return end.Sub(start)
@@ -8,6 +8,10 @@ import (
 	"github.com/stjudewashere/seonaut/internal/models"
 )
 
+// Number of PageReports whose extractions are loaded at once when streaming PageReports
+// with their extracted values.
+const extractionsBatchSize = 100
+
 type Cache interface {
 	Set(key string, v interface{}) error
 	Get(key string, v interface{}) error
@@ -17,17 +21,20 @@ type Cache interface {
 type ReportStore interface {
 	FindPageReportById(int) models.PageReport
 	FindErrorTypesByPage(int, int64) []string
-	FindInLinks(string, int64, int) []models.InternalLink
+	FindInLinks(string, int64, int, string) []models.InternalLink
 	FindPageReportsRedirectingToURL(string, int64, int) []models.PageReport
 	FindAllPageReportsByCrawlIdAndErrorType(int64, string) <-chan *models.PageReport
 	FindAllPageReportsByCrawlId(int64) <-chan *models.PageReport
 	FindSitemapPageReports(int64) <-chan *models.PageReport
 	FindLinks(pageReport *models.PageReport, cid int64, page int) []models.InternalLink
 	FindExternalLinks(pageReport *models.PageReport, cid int64, p int) []models.Link
-	FindPaginatedPageReports(cid int64, p int, term string) []models.PageReport
+	FindPaginatedPageReports(cid int64, p int, term string, sort string) []models.PageReport
+	FindExtractionNames(cid int64) []string
+	FindExtractions(cid int64, ids []int64) map[int64][]models.Extraction
+	FindPageReportKeywords(int) []models.Keyword
 
 	GetNumberOfPagesForPageReport(cid int64, term string) int
-	GetNumberOfPagesForInlinks(*models.PageReport, int64) int
+	GetNumberOfPagesForInlinks(*models.PageReport, int64, string) int
 	GetNumberOfPagesForRedirecting(*models.PageReport, int64) int
 	GetNumberOfPagesForLinks(*models.PageReport, int64) int
 	GetNumberOfPagesForExternalLinks(pageReport *models.PageReport, cid int64) int
@@ -39,6 +46,8 @@ type ReportStore interface {
 	CountImagesAlt(int64) *AltCount
 	CountScheme(int64) *SchemeCount
 	CountByNonCanonical(int64) int
+	CountSecurityHeadersByHost(int64) *SecurityHeadersCount
+	CountCacheByResourceType(int64) *CacheCount
 }
 
 type CanonicalCount struct {
@@ -51,6 +60,33 @@ type SchemeCount struct {
 	HTTPS int
 }
 
+// HostSecurityHeaders contains the number of successful HTML pages of a host and
+// how many of them send each of the security headers.
+type HostSecurityHeaders struct {
+	Host                    string
+	Pages                   int
+	StrictTransportSecurity int
+	ContentSecurityPolicy   int
+	XFrameOptions           int
+	XContentTypeOptions     int
+	ReferrerPolicy          int
+	PermissionsPolicy       int
+}
+
+type SecurityHeadersCount []HostSecurityHeaders
+
+// ResourceCache contains the number of successful responses of a resource type and how
+// many of them are cacheable, cacheable for at least a week and have cache validators.
+type ResourceCache struct {
+	Type       string
+	URLs       int
+	Cacheable  int
+	LongCache  int
+	Validators int
+}
+
+type CacheCount []ResourceCache
+
 type AltCount struct {
 	Alt    int
 	NonAlt int
@@ -77,8 +113,9 @@ func NewService(store ReportStore, cache Cache) *Service {
 }
 
 // Returns a PageReportView by PageReport Id and Crawl Id.
-// It also loads the data specified in the tab paramater.
-func (s *Service) GetPageReport(rid int, crawlId int64, tab string, page int) *PageReportView {
+// It also loads the data specified in the tab paramater. The inlinks tab can be filtered
+// by the position of the links in the page layout, all inlinks are loaded if position is empty.
+func (s *Service) GetPageReport(rid int, crawlId int64, tab string, page int, position string) *PageReportView {
 	paginator := models.Paginator{
 		CurrentPage: page,
 	}
@@ -96,11 +133,13 @@ func (s *Service) GetPageReport(rid int, crawlId int64, tab string, page int) *P
 		paginator.TotalPages = s.store.GetNumberOfPagesForExternalLinks(&v.PageReport, crawlId)
 		v.PageReport.ExternalLinks = s.store.FindExternalLinks(&v.PageReport, crawlId, page)
 	case "inlinks":
-		paginator.TotalPages = s.store.GetNumberOfPagesForInlinks(&v.PageReport, crawlId)
-		v.InLinks = s.store.FindInLinks(v.PageReport.URL, crawlId, page)
+		paginator.TotalPages = s.store.GetNumberOfPagesForInlinks(&v.PageReport, crawlId, position)
+		v.InLinks = s.store.FindInLinks(v.PageReport.URL, crawlId, page, position)
 	case "redirections":
 		paginator.TotalPages = s.store.GetNumberOfPagesForRedirecting(&v.PageReport, crawlId)
 		v.Redirects = s.store.FindPageReportsRedirectingToURL(v.PageReport.URL, crawlId, page)
+	case "keywords":
+		v.PageReport.Keywords = s.store.FindPageReportKeywords(rid)
 	}
 
 	if paginator.TotalPages == 0 {
@@ -130,7 +169,7 @@ func (s *Service) GetPageReporsByIssueType(crawlId int64, eid string) <-chan *mo
 }
 
 // Returns a PaginatorView with the corresponding page reports.
-func (s *Service) GetPaginatedReports(crawlId int64, currentPage int, term string) (models.PaginatorView, error) {
+func (s *Service) GetPaginatedReports(crawlId int64, currentPage int, term string, sort string) (models.PaginatorView, error) {
 	paginator := models.Paginator{
 		TotalPages:  s.store.GetNumberOfPagesForPageReport(crawlId, term),
 		CurrentPage: currentPage,
@@ -148,9 +187,23 @@ func (s *Service) GetPaginatedReports(crawlId int64, currentPage int, term strin
 		paginator.PreviousPage = currentPage - 1
 	}
 
+	pageReports := s.store.FindPaginatedPageReports(crawlId, currentPage, term, sort)
+
+	ids := []int64{}
+	for _, p := range pageReports {
+		ids = append(ids, p.Id)
+	}
+
+	if len(ids) > 0 {
+		extractions := s.store.FindExtractions(crawlId, ids)
+		for i := range pageReports {
+			pageReports[i].Extractions = extractions[pageReports[i].Id]
+		}
+	}
+
 	paginatorView := models.PaginatorView{
 		Paginator:   paginator,
-		PageReports: s.store.FindPaginatedPageReports(crawlId, currentPage, term),
+		PageReports: pageReports,
 	}
 
 	return paginatorView, nil
@@ -231,6 +284,34 @@ func (s *Service) GetCanonicalCount(crawlId int64) *CanonicalCount {
 	return c
 }
 
+// Returns the security headers count of the crawled HTML pages aggregated by host.
+func (s *Service) GetSecurityHeadersCount(crawlId int64) *SecurityHeadersCount {
+	key := fmt.Sprintf("security-%d", crawlId)
+	v := &SecurityHeadersCount{}
+	if err := s.cache.Get(key, v); err != nil {
+		v = s.store.CountSecurityHeadersByHost(crawlId)
+		if err := s.cache.Set(key, v); err != nil {
+			log.Printf("GetSecurityHeadersCount: cacheSet: %v\n", err)
+		}
+	}
+
+	return v
+}
+
+// Returns the caching headers count of the crawled URLs aggregated by resource type.
+func (s *Service) GetCacheCount(crawlId int64) *CacheCount {
+	key := fmt.Sprintf("caching-%d", crawlId)
+	v := &CacheCount{}
+	if err := s.cache.Get(key, v); err != nil {
+		v = s.store.CountCacheByResourceType(crawlId)
+		if err := s.cache.Set(key, v); err != nil {
+			log.Printf("GetCacheCount: cacheSet: %v\n", err)
+		}
+	}
+
+	return v
+}
+
 func (s *Service) BuildCrawlCache(crawl *models.Crawl) {
 	media := s.store.CountByMediaType(crawl.Id)
 	if err := s.cache.Set(fmt.Sprintf("media-%d", crawl.Id), media); err != nil {
@@ -260,6 +341,16 @@ func (s *Service) BuildCrawlCache(crawl *models.Crawl) {
 	if err := s.cache.Set(fmt.Sprintf("canonical-%d", crawl.Id), canonical); err != nil {
 		log.Printf("BuildDashboardCache: Canonical: %v\n", err)
 	}
+
+	security := s.store.CountSecurityHeadersByHost(crawl.Id)
+	if err := s.cache.Set(fmt.Sprintf("security-%d", crawl.Id), security); err != nil {
+		log.Printf("BuildDashboardCache: Security: %v\n", err)
+	}
+
+	caching := s.store.CountCacheByResourceType(crawl.Id)
+	if err := s.cache.Set(fmt.Sprintf("caching-%d", crawl.Id), caching); err != nil {
+		log.Printf("BuildDashboardCache: Caching: %v\n", err)
+	}
 }
 
 func (s *Service) RemoveCrawlCache(crawl *models.Crawl) {
@@ -282,4 +373,57 @@ func (s *Service) RemoveCrawlCache(crawl *models.Crawl) {
 	if err := s.cache.Delete(fmt.Sprintf("canonical-%d", crawl.Id)); err != nil {
 		log.Printf("DeleteDashboardCache: Canonical: %v\n", err)
 	}
+
+	if err := s.cache.Delete(fmt.Sprintf("security-%d", crawl.Id)); err != nil {
+		log.Printf("DeleteDashboardCache: Security: %v\n", err)
+	}
+
+	if err := s.cache.Delete(fmt.Sprintf("caching-%d", crawl.Id)); err != nil {
+		log.Printf("DeleteDashboardCache: Caching: %v\n", err)
+	}
+}
+
+// Returns the names of the custom extractors with values in the crawl.
+func (s *Service) GetExtractionNames(crawlId int64) []string {
+	return s.store.FindExtractionNames(crawlId)
+}
+
+// Returns a channel of PageReports by issue type with their extracted values.
+// The extractions are loaded in batches as the PageReports are streamed, so the
+// extracted values of the whole crawl are never kept in memory.
+func (s *Service) GetPageReportsWithExtractions(crawlId int64, eid string) <-chan *models.PageReport {
+	prStream := make(chan *models.PageReport)
+
+	go func() {
+		defer close(prStream)
+
+		batch := []*models.PageReport{}
+		send := func() {
+			ids := []int64{}
+			for _, p := range batch {
+				ids = append(ids, p.Id)
+			}
+
+			extractions := s.store.FindExtractions(crawlId, ids)
+			for _, p := range batch {
+				p.Extractions = extractions[p.Id]
+				prStream <- p
+			}
+
+			batch = []*models.PageReport{}
+		}
+
+		for p := range s.GetPageReporsByIssueType(crawlId, eid) {
+			batch = append(batch, p)
+			if len(batch) == extractionsBatchSize {
+				send()
+			}
+		}
+
+		if len(batch) > 0 {
+			send()
+		}
+	}()
+
+	return prStream
 }
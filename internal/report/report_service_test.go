@@ -42,6 +42,14 @@ func (s *storage) CountByNonCanonical(i int64) int {
 	return 0
 }
 
+func (s *storage) CountSecurityHeadersByHost(i int64) *report.SecurityHeadersCount {
+	return &report.SecurityHeadersCount{}
+}
+
+func (s *storage) CountCacheByResourceType(i int64) *report.CacheCount {
+	return &report.CacheCount{}
+}
+
 func (s *storage) FindExternalLinks(pageReport *models.PageReport, cid int64, p int) []models.Link {
 	return []models.Link{}
 }
@@ -70,11 +78,11 @@ func (s *storage) FindErrorTypesByPage(reportId int, crawlId int64) []string {
 	return []string{errorType}
 }
 
-func (s *storage) FindInLinks(u string, id int64, page int) []models.InternalLink {
+func (s *storage) FindInLinks(u string, id int64, page int, position string) []models.InternalLink {
 	return []models.InternalLink{{PageReport: models.PageReport{Id: reportId}}}
 }
 
-func (s *storage) GetNumberOfPagesForInlinks(pageReport *models.PageReport, cid int64) int {
+func (s *storage) GetNumberOfPagesForInlinks(pageReport *models.PageReport, cid int64, position string) int {
 	return 1
 }
 
@@ -117,10 +125,22 @@ func (s *storage) FindSitemapPageReports(id int64) <-chan *models.PageReport {
 	return prStream
 }
 
-func (s *storage) FindPaginatedPageReports(cid int64, p int, term string) []models.PageReport {
+func (s *storage) FindPaginatedPageReports(cid int64, p int, term string, sort string) []models.PageReport {
 	return []models.PageReport{}
 }
 
+func (s *storage) FindExtractionNames(cid int64) []string {
+	return []string{}
+}
+
+func (s *storage) FindExtractions(cid int64, ids []int64) map[int64][]models.Extraction {
+	return map[int64][]models.Extraction{}
+}
+
+func (s *storage) FindPageReportKeywords(rid int) []models.Keyword {
+	return []models.Keyword{}
+}
+
 func (s *storage) GetNumberOfPagesForPageReport(cid int64, term string) int {
 	return 0
 }
@@ -177,7 +197,7 @@ func TestGetPageReporsByIssueType(t *testing.T) {
 }
 
 func TestGetPageReport(t *testing.T) {
-	v := service.GetPageReport(reportId, crawlId, tabInlinks, page)
+	v := service.GetPageReport(reportId, crawlId, tabInlinks, page, "")
 	if v.PageReport.Id != reportId {
 		t.Errorf("GetPageReport: %d != %d", v.PageReport.Id, reportId)
 	}
@@ -194,7 +214,7 @@ func TestGetPageReport(t *testing.T) {
 		t.Errorf("v.Redirects: %d != 0", len(v.Redirects))
 	}
 
-	vr := service.GetPageReport(reportId, crawlId, tabRedirections, page)
+	vr := service.GetPageReport(reportId, crawlId, tabRedirections, page, "")
 	if len(vr.InLinks) != 0 {
 		t.Errorf("v.InLinks: %d != 0", len(vr.InLinks))
 	}
@@ -13,10 +13,13 @@ import (
 )
 
 // The PageIssueReporter struct contains a callback function and an error type.
-// Each PageIssueReporter callback will be called and an issue will be created if it returns true.
+// Each PageIssueReporter callback will be called with the project's thresholds and an issue
+// will be created if it returns true. UsesThresholds must be set if the callback depends on
+// the thresholds, so its issues can be re-evaluated when the project's thresholds change.
 type PageIssueReporter struct {
-	Callback  func(*models.PageReport) bool
-	ErrorType int
+	Callback       func(*models.PageReport, *models.Thresholds) bool
+	ErrorType      int
+	UsesThresholds bool
 }
 
 // The MultipageIssueReporter struct contains an int64 stream, which corresponds to the PageReport id,
@@ -31,12 +34,15 @@ type MultipageCallback func(c *models.Crawl) *MultipageIssueReporter
 
 type ReportManagerStore interface {
 	SaveIssues(<-chan *models.Issue)
+	DeleteIssuesByErrorType(cid int64, errorTypes []int)
+	FindAllPageReportsWithLinksByCrawlId(cid int64) <-chan *models.PageReport
 }
 
 type ReportManager struct {
-	store              ReportManagerStore
-	pageCallbacks      []*PageIssueReporter
-	multipageCallbacks []MultipageCallback
+	store                       ReportManagerStore
+	pageCallbacks               []*PageIssueReporter
+	multipageCallbacks          []MultipageCallback
+	thresholdMultipageCallbacks []MultipageCallback
 }
 
 // Create a new ReportManager with no issue reporters.
@@ -59,9 +65,17 @@ func (rm *ReportManager) AddMultipageReporter(reporter MultipageCallback) {
 	rm.multipageCallbacks = append(rm.multipageCallbacks, reporter)
 }
 
+// Add a multi-page issue reporter that depends on the project's thresholds to the ReportManager.
+// It will be used when creating the multi page issues, and its issues will be re-evaluated
+// when the project's thresholds change.
+func (rm *ReportManager) AddThresholdMultipageReporter(reporter MultipageCallback) {
+	rm.multipageCallbacks = append(rm.multipageCallbacks, reporter)
+	rm.thresholdMultipageCallbacks = append(rm.thresholdMultipageCallbacks, reporter)
+}
+
 // CreatePageIssues loops the page reporters calling the callback function
 // and creating the issues found in the PageReport.
-func (r *ReportManager) CreatePageIssues(p *models.PageReport, crawl *models.Crawl) {
+func (r *ReportManager) CreatePageIssues(p *models.PageReport, crawl *models.Crawl, t *models.Thresholds) {
 	iStream := make(chan *models.Issue)
 	wg := new(sync.WaitGroup)
 	wg.Add(1)
@@ -72,7 +86,7 @@ func (r *ReportManager) CreatePageIssues(p *models.PageReport, crawl *models.Cra
 	}()
 
 	for _, c := range r.pageCallbacks {
-		if c.Callback(p) == true {
+		if c.Callback(p, t) == true {
 			iStream <- &models.Issue{
 				PageReportId: p.Id,
 				CrawlId:      crawl.Id,
@@ -112,3 +126,80 @@ func (r *ReportManager) CreateMultipageIssues(crawl *models.Crawl) {
 
 	wg.Wait()
 }
+
+// UpdateThresholdIssues re-evaluates the page and multi-page reporters that depend on the project's
+// thresholds against the page reports of an existing crawl. The issues previously created by these
+// reporters are deleted and created again using the new thresholds.
+func (r *ReportManager) UpdateThresholdIssues(crawl *models.Crawl, t *models.Thresholds) {
+	reporters := []*PageIssueReporter{}
+	errorTypes := []int{}
+	for _, c := range r.pageCallbacks {
+		if c.UsesThresholds {
+			reporters = append(reporters, c)
+			errorTypes = append(errorTypes, c.ErrorType)
+		}
+	}
+
+	if len(reporters) > 0 {
+		r.store.DeleteIssuesByErrorType(crawl.Id, errorTypes)
+		r.updatePageThresholdIssues(crawl, t, reporters)
+	}
+
+	for _, callback := range r.thresholdMultipageCallbacks {
+		reporter := callback(crawl)
+		r.store.DeleteIssuesByErrorType(crawl.Id, []int{reporter.ErrorType})
+		r.saveMultipageIssues(crawl, reporter)
+	}
+}
+
+// Creates the issues of the page reporters for all the page reports in the crawl.
+func (r *ReportManager) updatePageThresholdIssues(crawl *models.Crawl, t *models.Thresholds, reporters []*PageIssueReporter) {
+	iStream := make(chan *models.Issue)
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+
+	go func() {
+		r.store.SaveIssues(iStream)
+		wg.Done()
+	}()
+
+	for p := range r.store.FindAllPageReportsWithLinksByCrawlId(crawl.Id) {
+		for _, c := range reporters {
+			if c.Callback(p, t) == true {
+				iStream <- &models.Issue{
+					PageReportId: p.Id,
+					CrawlId:      crawl.Id,
+					ErrorType:    c.ErrorType,
+				}
+			}
+		}
+	}
+
+	close(iStream)
+
+	wg.Wait()
+}
+
+// Creates the issues of the page reports received from the multi-page reporter.
+func (r *ReportManager) saveMultipageIssues(crawl *models.Crawl, reporter *MultipageIssueReporter) {
+	iStream := make(chan *models.Issue)
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+
+	go func() {
+		r.store.SaveIssues(iStream)
+		wg.Done()
+	}()
+
+	for pid := range reporter.Pstream {
+		iStream <- &models.Issue{
+			PageReportId: pid,
+			CrawlId:      crawl.Id,
+			ErrorType:    reporter.ErrorType,
+		}
+	}
+
+	close(iStream)
+
+	wg.Wait()
+}
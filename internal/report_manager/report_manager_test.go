@@ -15,7 +15,8 @@ const (
 
 // Mock storage contains an Issues slice so we can test if issues are being received.
 type mockStorage struct {
-	Issues []*models.Issue
+	Issues      []*models.Issue
+	PageReports []*models.PageReport
 }
 
 // SaveIssues appends the issue to the Issues slice.
@@ -25,6 +26,39 @@ func (s *mockStorage) SaveIssues(c <-chan *models.Issue) {
 	}
 }
 
+// DeleteIssuesByErrorType removes the issues of the given error types from the Issues slice.
+func (s *mockStorage) DeleteIssuesByErrorType(cid int64, errorTypes []int) {
+	issues := []*models.Issue{}
+	for _, i := range s.Issues {
+		keep := true
+		for _, e := range errorTypes {
+			if i.CrawlId == cid && i.ErrorType == e {
+				keep = false
+			}
+		}
+
+		if keep {
+			issues = append(issues, i)
+		}
+	}
+
+	s.Issues = issues
+}
+
+// FindAllPageReportsWithLinksByCrawlId sends the PageReports slice through the stream.
+func (s *mockStorage) FindAllPageReportsWithLinksByCrawlId(cid int64) <-chan *models.PageReport {
+	stream := make(chan *models.PageReport)
+
+	go func() {
+		for _, p := range s.PageReports {
+			stream <- p
+		}
+		close(stream)
+	}()
+
+	return stream
+}
+
 // Add a PageReporter and test if new issue is sent to the storage.
 func TestCreatePageIssuesCreatesIssue(t *testing.T) {
 
@@ -36,7 +70,7 @@ func TestCreatePageIssuesCreatesIssue(t *testing.T) {
 	service.AddPageReporter(
 		&report_manager.PageIssueReporter{
 			ErrorType: errorType,
-			Callback: func(pageReport *models.PageReport) bool {
+			Callback: func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 				return true
 			},
 		})
@@ -48,7 +82,7 @@ func TestCreatePageIssuesCreatesIssue(t *testing.T) {
 	// Create the PageIssues should run the PageIssueReporter that returns true
 	// indicating an issue was found, so a new issue should be created and added
 	// to the mockStorage.
-	service.CreatePageIssues(pageReport, crawl)
+	service.CreatePageIssues(pageReport, crawl, models.NewThresholds())
 
 	// The storage should contain exactly one issue.
 	if len(storage.Issues) != 1 {
@@ -82,7 +116,7 @@ func TestCreatePageIssuesDoesNotCreateIssue(t *testing.T) {
 	service.AddPageReporter(
 		&report_manager.PageIssueReporter{
 			ErrorType: errorType,
-			Callback: func(pageReport *models.PageReport) bool {
+			Callback: func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 				return false
 			},
 		})
@@ -93,7 +127,7 @@ func TestCreatePageIssuesDoesNotCreateIssue(t *testing.T) {
 
 	// Create the PageIssues should run the PageIssueReporter that returns false
 	// indicating an issue was not found and will not be created.
-	service.CreatePageIssues(pageReport, crawl)
+	service.CreatePageIssues(pageReport, crawl, models.NewThresholds())
 
 	// The storage issues slice should be empty.
 	if len(storage.Issues) != 0 {
@@ -147,3 +181,101 @@ func TestCreateMultiPageIssues(t *testing.T) {
 		t.Errorf("CreatePageIsssues: crawlId %d != %d", issue.ErrorType, errorType)
 	}
 }
+
+// Add a PageReporter that uses the thresholds and test if its issues are re-evaluated
+// when the thresholds change, while issues from other reporters are kept.
+func TestUpdateThresholdIssues(t *testing.T) {
+	const otherErrorType = 2
+
+	storage := &mockStorage{
+		PageReports: []*models.PageReport{{Id: pageReportId, Words: 150}},
+	}
+	service := report_manager.NewReportManager(storage)
+
+	service.AddPageReporter(
+		&report_manager.PageIssueReporter{
+			ErrorType: errorType,
+			Callback: func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+				return pageReport.Words < thresholds.MinWords
+			},
+			UsesThresholds: true,
+		})
+
+	service.AddPageReporter(
+		&report_manager.PageIssueReporter{
+			ErrorType: otherErrorType,
+			Callback: func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+				return true
+			},
+		})
+
+	crawl := &models.Crawl{Id: crawlId}
+	service.CreatePageIssues(storage.PageReports[0], crawl, models.NewThresholds())
+
+	if len(storage.Issues) != 2 {
+		t.Fatalf("UpdateThresholdIssues: CreatePageIssues %d != 2", len(storage.Issues))
+	}
+
+	// With a lower minimum of words the page no longer has the threshold issue.
+	thresholds := models.NewThresholds()
+	thresholds.MinWords = 100
+	service.UpdateThresholdIssues(crawl, thresholds)
+
+	if len(storage.Issues) != 1 {
+		t.Fatalf("UpdateThresholdIssues: %d != 1", len(storage.Issues))
+	}
+
+	if storage.Issues[0].ErrorType != otherErrorType {
+		t.Errorf("UpdateThresholdIssues: ErrorType %d != %d", storage.Issues[0].ErrorType, otherErrorType)
+	}
+
+	// Raising the minimum again creates the threshold issue.
+	thresholds.MinWords = 300
+	service.UpdateThresholdIssues(crawl, thresholds)
+
+	if len(storage.Issues) != 2 {
+		t.Errorf("UpdateThresholdIssues: %d != 2", len(storage.Issues))
+	}
+}
+
+// Add a multi-page reporter that uses the thresholds and test if it is run again when the
+// thresholds change, replacing its issues, while other multi-page reporters are not run.
+func TestUpdateThresholdMultipageIssues(t *testing.T) {
+	const otherErrorType = 2
+
+	storage := &mockStorage{}
+	service := report_manager.NewReportManager(storage)
+
+	calls := map[int]int{}
+	reporter := func(e int) report_manager.MultipageCallback {
+		return func(c *models.Crawl) *report_manager.MultipageIssueReporter {
+			calls[e]++
+			stream := make(chan int64)
+
+			go func() {
+				stream <- pageReportId
+				close(stream)
+			}()
+
+			return &report_manager.MultipageIssueReporter{
+				Pstream:   stream,
+				ErrorType: e,
+			}
+		}
+	}
+
+	service.AddThresholdMultipageReporter(reporter(errorType))
+	service.AddMultipageReporter(reporter(otherErrorType))
+
+	crawl := &models.Crawl{Id: crawlId}
+	service.CreateMultipageIssues(crawl)
+	service.UpdateThresholdIssues(crawl, models.NewThresholds())
+
+	if len(storage.Issues) != 2 {
+		t.Errorf("UpdateThresholdIssues: %d != 2", len(storage.Issues))
+	}
+
+	if calls[errorType] != 2 || calls[otherErrorType] != 1 {
+		t.Errorf("UpdateThresholdIssues: calls %v", calls)
+	}
+}
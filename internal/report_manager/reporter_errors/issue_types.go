@@ -0,0 +1,188 @@
+package reporter_errors
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/stjudewashere/seonaut/internal/issue"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// Issue categories. The category names are used as translation keys.
+const (
+	CategoryStatus         = "CATEGORY_STATUS"
+	CategoryTitles         = "CATEGORY_TITLES"
+	CategoryContent        = "CATEGORY_CONTENT"
+	CategoryImages         = "CATEGORY_IMAGES"
+	CategoryHeadings       = "CATEGORY_HEADINGS"
+	CategoryLanguage       = "CATEGORY_LANGUAGE"
+	CategoryLinks          = "CATEGORY_LINKS"
+	CategoryHreflang       = "CATEGORY_HREFLANG"
+	CategoryCanonical      = "CATEGORY_CANONICAL"
+	CategoryIndexability   = "CATEGORY_INDEXABILITY"
+	CategoryStructuredData = "CATEGORY_STRUCTURED_DATA"
+	CategorySocial         = "CATEGORY_SOCIAL"
+	CategoryPageSetup      = "CATEGORY_PAGE_SETUP"
+	CategoryAccessibility  = "CATEGORY_ACCESSIBILITY"
+	CategoryPDF            = "CATEGORY_PDF"
+	CategorySecurity       = "CATEGORY_SECURITY"
+	CategoryCaching        = "CATEGORY_CACHING"
+)
+
+// The categories in the order they are listed.
+var categories = []string{
+	CategoryStatus,
+	CategoryTitles,
+	CategoryContent,
+	CategoryImages,
+	CategoryHeadings,
+	CategoryLanguage,
+	CategoryLinks,
+	CategoryHreflang,
+	CategoryCanonical,
+	CategoryIndexability,
+	CategoryStructuredData,
+	CategorySocial,
+	CategoryPageSetup,
+	CategoryAccessibility,
+	CategoryPDF,
+	CategorySecurity,
+	CategoryCaching,
+}
+
+// The issue types registry with the issue types declared with the register function. The code
+// is stored in the issue_types table and the English title and description are added to the
+// translations with the code and the code followed by "_DESC" as keys.
+var issueTypes []models.IssueType
+
+// register adds the issue type to the registry and returns its id.
+func register(t models.IssueType) int {
+	issueTypes = append(issueTypes, t)
+
+	return t.Id
+}
+
+// IssueCategory contains the issue types that belong to the same category.
+type IssueCategory struct {
+	Name       string
+	IssueTypes []models.IssueType
+}
+
+// GetAllIssueTypes returns all the registered issue types.
+func GetAllIssueTypes() []models.IssueType {
+	return issueTypes
+}
+
+// GetIssueType returns the registered issue type with the specified id.
+// The second return value is false if the id has not been registered.
+func GetIssueType(id int) (models.IssueType, bool) {
+	for _, t := range issueTypes {
+		if t.Id == id {
+			return t, true
+		}
+	}
+
+	return models.IssueType{}, false
+}
+
+// GetIssueTypeByCode returns the registered issue type with the specified code.
+// The second return value is false if the code has not been registered.
+func GetIssueTypeByCode(code string) (models.IssueType, bool) {
+	for _, t := range issueTypes {
+		if t.Code == code {
+			return t, true
+		}
+	}
+
+	return models.IssueType{}, false
+}
+
+// GetIssueCategories returns the registered issue types grouped by category.
+// The issue types in each category are sorted by priority.
+func GetIssueCategories() []IssueCategory {
+	ic := []IssueCategory{}
+	for _, c := range categories {
+		category := IssueCategory{Name: c}
+		for _, t := range issueTypes {
+			if t.Category == c {
+				category.IssueTypes = append(category.IssueTypes, t)
+			}
+		}
+
+		sort.SliceStable(category.IssueTypes, func(i, j int) bool {
+			return category.IssueTypes[i].Priority < category.IssueTypes[j].Priority
+		})
+
+		ic = append(ic, category)
+	}
+
+	return ic
+}
+
+// Translations returns the translations of the registered issue types. The title is
+// keyed by the issue type code and the description by the code followed by "_DESC".
+// Titles and descriptions not declared in the registry are left out.
+func Translations() map[string]string {
+	t := make(map[string]string)
+	for _, it := range issueTypes {
+		if it.Title != "" {
+			t[it.Code] = it.Title
+		}
+
+		if it.Description != "" {
+			t[it.Code+"_DESC"] = it.Description
+		}
+	}
+
+	return t
+}
+
+// Validate checks the registry is consistent. Issue type ids and codes must be unique and
+// the priority and category must be valid. The hasTranslation function must check the
+// translations file before the registry translations are added to it. It must return true
+// for the issue's category, as well as for the issue's title and description keys unless
+// they are declared in the registry.
+func Validate(hasTranslation func(string) bool) error {
+	ids := make(map[int]bool)
+	codes := make(map[string]bool)
+	for _, t := range issueTypes {
+		if ids[t.Id] {
+			return fmt.Errorf("issue type %d is registered more than once", t.Id)
+		}
+		ids[t.Id] = true
+
+		if codes[t.Code] {
+			return fmt.Errorf("issue type code %s is registered more than once", t.Code)
+		}
+		codes[t.Code] = true
+
+		if t.Priority < issue.Critical || t.Priority > issue.Accessibility {
+			return fmt.Errorf("issue type %s has an invalid priority %d", t.Code, t.Priority)
+		}
+
+		validCategory := false
+		for _, c := range categories {
+			if c == t.Category {
+				validCategory = true
+			}
+		}
+
+		if !validCategory {
+			return fmt.Errorf("issue type %s has an invalid category %s", t.Code, t.Category)
+		}
+
+		if t.Title == "" && !hasTranslation(t.Code) {
+			return fmt.Errorf("issue type %s is missing its title", t.Code)
+		}
+
+		if t.Description == "" && !hasTranslation(t.Code+"_DESC") {
+			return fmt.Errorf("issue type %s is missing its description", t.Code)
+		}
+
+		if !hasTranslation(t.Category) {
+			return fmt.Errorf("issue type %s is missing the %s translation", t.Code, t.Category)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package reporter_errors_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/issue"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Test the registered issue types are valid and can be translated with the translations
+// file, before the registry translations are added to it.
+func TestValidate(t *testing.T) {
+	translation, err := ioutil.ReadFile("../../../translations/translation.en.yaml")
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	m := make(map[string]interface{})
+	if err := yaml.Unmarshal(translation, &m); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	hasTranslation := func(key string) bool {
+		_, ok := m[key]
+		return ok
+	}
+
+	if err := reporter_errors.Validate(hasTranslation); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+
+	missingTranslations := func(key string) bool {
+		return false
+	}
+
+	if err := reporter_errors.Validate(missingTranslations); err == nil {
+		t.Errorf("Validate: missing translations should return an error")
+	}
+}
+
+// Test the issue types are returned by id and grouped by category.
+func TestGetIssueType(t *testing.T) {
+	it, ok := reporter_errors.GetIssueType(reporter_errors.Error30x)
+	if !ok {
+		t.Fatalf("GetIssueType: Error30x should be registered")
+	}
+
+	if it.Code != "ERROR_30x" || it.Priority != issue.Critical || it.Category != reporter_errors.CategoryStatus {
+		t.Errorf("GetIssueType: unexpected issue type %+v", it)
+	}
+
+	if it.Title == "" || it.Description == "" {
+		t.Errorf("GetIssueType: Error30x should declare its title and description")
+	}
+
+	if reporter_errors.Translations()["ERROR_30x_DESC"] != it.Description {
+		t.Errorf("Translations: ERROR_30x_DESC should be the issue type description")
+	}
+
+	if _, ok := reporter_errors.GetIssueType(0); ok {
+		t.Errorf("GetIssueType: 0 should not be registered")
+	}
+
+	total := 0
+	for _, c := range reporter_errors.GetIssueCategories() {
+		total += len(c.IssueTypes)
+	}
+
+	if total != len(reporter_errors.GetAllIssueTypes()) {
+		t.Errorf("GetIssueCategories: %d != %d", total, len(reporter_errors.GetAllIssueTypes()))
+	}
+}
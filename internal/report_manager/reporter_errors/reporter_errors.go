@@ -1,54 +1,1125 @@
-// Package reporter_errors defines constants for the different types of errors
-// that can be found by the reporters.
+// Package reporter_errors declares the types of issues that can be found by the reporters.
 //
-// This package contains constants for different error types, where each constant
-// has a unique integer ID that matches the corresponding error's ID in the database.
-// These constants help identify and handle specific types of errors in the code.
-// Additionally, reporters use these constants to report the type of issue they have
-// found in the crawled pages.
+// Each issue type is declared once with the register function, which adds its id, code,
+// priority, category, title and description to the issue types registry and returns its id.
+// The id matches the issue type's id in the database, and the registry is synced to the
+// issue_types table on startup. Reporters use these ids to report the type of issue they
+// have found in the crawled pages.
 package reporter_errors
 
-const (
-	Error30x                         = iota + 1 // HTTP redirect
-	Error40x                                    // HTTP not found
-	Error50x                                    // HTTP internal error
-	ErrorDuplicatedTitle                        // Duplicate title
-	ErrorDuplicatedDescription                  // Duplicate description
-	ErrorEmptyTitle                             // Missing or empty title
-	ErrorShortTitle                             // Page title is too short
-	ErrorLongTitle                              // Page title is too long
-	ErrorEmptyDescription                       // Missing or empty meta description
-	ErrorShortDescription                       // Meta description is too short
-	ErrorLongDescription                        // Meta description is too long
-	ErrorLittleContent                          // Not enough content
-	ErrorImagesWithNoAlt                        // Images with no alt attribute
-	ErrorRedirectChain                          // Redirect chain
-	ErrorNoH1                                   // Missing or empy H1 tag
-	ErrorNoLang                                 // Missing or empty html lang attribute
-	ErrorHTTPLinks                              // Links using insecure http schema
-	ErrorHreflangsReturnLink                    // Hreflang is not bidirectional
-	ErrorTooManyLinks                           // Page contains too many links
-	ErrorInternalNoFollow                       // Page has internal links with nofollow attribute
-	ErrorExternalWithoutNoFollow                // Page has external follow links
-	ErrorCanonicalizedToNonCanonical            // Page canonicalized to a non canonical page
-	ErrorRedirectLoop                           // Redirect loop
-	ErrorNotValidHeadings                       // H1-H6 tags have wrong order
-	ErrorHreflangToNonCanonical                 // Hreflang to non canonical page
-	ErrorInternalNoFollowIndexable              // Nofollow links to indexable pages
-	ErrorNoIndexable                            // Page using the noindex attribute
-	ErrorHreflangNoindexable                    // Hreflang to a non indexable page
-	ErrorBlocked                                // Blocked by robots.txt
-	ErrorOrphan                                 // Orphan pages
-	ErrorSitemapNoIndex                         // No index pages included in the sitemap
-	ErrorSitemapBlocked                         // Pages included in the sitemap that are blocked in robots.txt
-	ErrorSitemapNonCanonical                    // Non canonical pages included in the sitemap
-	ErrorIncomingFollowNofollow                 // Pages with follow and nofollow incoming links
-	ErrorInvalidLanguage                        // Pages with invalid lang attribute
-	ErrorHTTPScheme                             // Pages using http scheme instead of https
-	ErrorDeadend                                // Pages with no outgoing internal or external links
-	ErrorCanonicalizedToNonIndexable            // Pages that are canonicalized to non-indexable pages
-	ErrorHreflangToRedirect                     // Pages that have hreflang links to other redirected pages
-	ErrorCanonicalizedToRedirect                // Pages that are canonicalized to other redirected pages
-	ErrorHreflangToError                        // Pages that have hreflang links to error pages
-	ErrorCanonicalizedToError                   // Pages that are canonicalized to error pages
+import (
+	"github.com/stjudewashere/seonaut/internal/issue"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+var (
+	// HTTP redirect
+	Error30x = register(models.IssueType{
+		Id:          1,
+		Code:        "ERROR_30x",
+		Priority:    issue.Critical,
+		Category:    CategoryStatus,
+		Title:       "Status 30x",
+		Description: "URLs causing redirects are sending users and search engines to a different page, this can waste the search engine's crawl budget and create redirect chains.",
+	})
+
+	// HTTP not found
+	Error40x = register(models.IssueType{
+		Id:          2,
+		Code:        "ERROR_40x",
+		Priority:    issue.Critical,
+		Category:    CategoryStatus,
+		Title:       "Status 40x",
+		Description: "This pages are missing and both your users and search engines are getting lost.",
+	})
+
+	// HTTP internal error
+	Error50x = register(models.IssueType{
+		Id:          3,
+		Code:        "ERROR_50x",
+		Priority:    issue.Critical,
+		Category:    CategoryStatus,
+		Title:       "Status 50x",
+		Description: "This kind of errors usually occour due to a server bug or missconfiguration, the affected pages don't load properly and show an error page instead, scaring your users and annoying search engines.",
+	})
+
+	// Duplicate title
+	ErrorDuplicatedTitle = register(models.IssueType{
+		Id:          4,
+		Code:        "ERROR_DUPLICATED_TITLE",
+		Priority:    issue.Alert,
+		Category:    CategoryTitles,
+		Title:       "Duplicated title",
+		Description: "The title tag is considered one of the most important elements for on-page SEO. If multiple pages have the same title, they will compete for a given search query confusing search engines.",
+	})
+
+	// Duplicate description
+	ErrorDuplicatedDescription = register(models.IssueType{
+		Id:          5,
+		Code:        "ERROR_DUPLICATED_DESCRIPTION",
+		Priority:    issue.Alert,
+		Category:    CategoryTitles,
+		Title:       "Duplicated description",
+		Description: "It is recomended to have unique descriptions for each page so search engines can understand better your page.",
+	})
+
+	// Missing or empty title
+	ErrorEmptyTitle = register(models.IssueType{
+		Id:          6,
+		Code:        "ERROR_EMPTY_TITLE",
+		Priority:    issue.Alert,
+		Category:    CategoryTitles,
+		Title:       "Missing title",
+		Description: "The page title is one of the most important elements for search engines.",
+	})
+
+	// Page title is too short
+	ErrorShortTitle = register(models.IssueType{
+		Id:          7,
+		Code:        "ERROR_SHORT_TITLE",
+		Priority:    issue.Alert,
+		Category:    CategoryTitles,
+		Title:       "Short title",
+		Description: "This pages have titles that are too short. Search engines will be happier if you provide longer titles.",
+	})
+
+	// Page title is too long
+	ErrorLongTitle = register(models.IssueType{
+		Id:          8,
+		Code:        "ERROR_LONG_TITLE",
+		Priority:    issue.Alert,
+		Category:    CategoryTitles,
+		Title:       "Long title",
+		Description: "Long titles won't affect your on-page SEO, but they are usually cut off on search results and tend to get fewer clicks.",
+	})
+
+	// Missing or empty meta description
+	ErrorEmptyDescription = register(models.IssueType{
+		Id:          9,
+		Code:        "ERROR_EMPTY_DESCRIPTION",
+		Priority:    issue.Alert,
+		Category:    CategoryTitles,
+		Title:       "Missing description",
+		Description: "Page description is not a ranking factor for search engines, but they often use it to show snippets on search results.",
+	})
+
+	// Meta description is too short
+	ErrorShortDescription = register(models.IssueType{
+		Id:          10,
+		Code:        "ERROR_SHORT_DESCRIPTION",
+		Priority:    issue.Alert,
+		Category:    CategoryTitles,
+		Title:       "Short description",
+		Description: "Page description is not a ranking factor for search engines, but they often use it to show snippets on search results.",
+	})
+
+	// Meta description is too long
+	ErrorLongDescription = register(models.IssueType{
+		Id:          11,
+		Code:        "ERROR_LONG_DESCRIPTION",
+		Priority:    issue.Alert,
+		Category:    CategoryTitles,
+		Title:       "Long description",
+		Description: "Description tags are not a ranking factor for search engines, but having good quality descriptions will get more accurate information in the search results. Long descriptions will often get truncated, making them not so sexy for users.",
+	})
+
+	// Not enough content
+	ErrorLittleContent = register(models.IssueType{
+		Id:          12,
+		Code:        "ERROR_LITTLE_CONTENT",
+		Priority:    issue.Warning,
+		Category:    CategoryContent,
+		Title:       "Little content",
+		Description: "Pages with little content are not really useful for users or search engines, consider adding more content to your pages.",
+	})
+
+	// Images with no alt attribute
+	ErrorImagesWithNoAlt = register(models.IssueType{
+		Id:          13,
+		Code:        "ERROR_IMAGES_NO_ALT",
+		Priority:    issue.Alert,
+		Category:    CategoryImages,
+		Title:       "No alt attribute",
+		Description: "The image alt attribute improves your site's accessibility, it also helps search engines understand better your images.",
+	})
+
+	// Redirect chain
+	ErrorRedirectChain = register(models.IssueType{
+		Id:          14,
+		Code:        "ERROR_REDIRECT_CHAIN",
+		Priority:    issue.Critical,
+		Category:    CategoryStatus,
+		Title:       "Redirect chain",
+		Description: "Redirect chains are URLs that redirect to other URLs that also redirect somewhere else, creating a chain of redirects.",
+	})
+
+	// Missing or empy H1 tag
+	ErrorNoH1 = register(models.IssueType{
+		Id:          15,
+		Code:        "ERROR_NO_H1",
+		Priority:    issue.Alert,
+		Category:    CategoryHeadings,
+		Title:       "Missing H1",
+		Description: "The H1 tag describes the main topic of the page, without it both your users and search engine will find it harder to figure what the page is talking about.",
+	})
+
+	// Missing or empty html lang attribute
+	ErrorNoLang = register(models.IssueType{
+		Id:          16,
+		Code:        "ERROR_NO_LANG",
+		Priority:    issue.Warning,
+		Category:    CategoryLanguage,
+		Title:       "Missing language attribute",
+		Description: "The language attribute is particularly usefull for screen readers and it's recommended to use it. Some search engines use it to show the appropiate page to the users depending on their language, but it does not affect your search engine ranking.",
+	})
+
+	// Links using insecure http schema
+	ErrorHTTPLinks = register(models.IssueType{
+		Id:          17,
+		Code:        "ERROR_HTTP_LINKS",
+		Priority:    issue.Alert,
+		Category:    CategoryLinks,
+		Title:       "HTTPS to HTTP",
+		Description: "Links from secure HTTPS pages to unsecured HTTP ones. Having a fully secure website rank better than those without HTTPS, it also makes your users happier.",
+	})
+
+	// Hreflang is not bidirectional
+	ErrorHreflangsReturnLink = register(models.IssueType{
+		Id:          18,
+		Code:        "ERROR_HREFLANG_RETURN",
+		Priority:    issue.Alert,
+		Category:    CategoryHreflang,
+		Title:       "Missing hreflang",
+		Description: "When referencing a page with hreflang, that page must reference back with a hreflang tag too.",
+	})
+
+	// Page contains too many links
+	ErrorTooManyLinks = register(models.IssueType{
+		Id:          19,
+		Code:        "ERROR_TOO_MANY_LINKS",
+		Priority:    issue.Warning,
+		Category:    CategoryLinks,
+		Title:       "Too many Links",
+		Description: "The SEO link juice is divided between all the links in the page, the more links in a page the less amount of juice is passed to them. Make sure all links are relevant, remove the ones that aren't or use the nofollow attribute.",
+	})
+
+	// Page has internal links with nofollow attribute
+	ErrorInternalNoFollow = register(models.IssueType{
+		Id:          20,
+		Code:        "ERROR_INTERNAL_NOFOLLOW",
+		Priority:    issue.Warning,
+		Category:    CategoryLinks,
+		Title:       "Internal nofollow links",
+		Description: "Internal links in general should not use the nofollow attribute unless they link to pages you don't want indexed by search engines. Review the internal links and make sure important pages are linked without the nofollow attribute.",
+	})
+
+	// Page has external follow links
+	ErrorExternalWithoutNoFollow = register(models.IssueType{
+		Id:          21,
+		Code:        "ERROR_EXTERNAL_WITHOUT_NOFOLLOW",
+		Priority:    issue.Warning,
+		Category:    CategoryLinks,
+		Title:       "External follow links",
+		Description: "Follow links will pass SEO authority to external sites. You may want to keep an eye on it to avoid link spam in comments and user generated content.",
+	})
+
+	// Page canonicalized to a non canonical page
+	ErrorCanonicalizedToNonCanonical = register(models.IssueType{
+		Id:          22,
+		Code:        "ERROR_CANONICALIZED_NON_CANONICAL",
+		Priority:    issue.Alert,
+		Category:    CategoryCanonical,
+		Title:       "Canonicalized to non canonical",
+		Description: "Pages canonicalized to a non canonical URL. Canonicalized pages should point to a canonical page, replace the canonical link URL in this pages so they point to a canonical one.",
+	})
+
+	// Redirect loop
+	ErrorRedirectLoop = register(models.IssueType{
+		Id:          23,
+		Code:        "ERROR_REDIRECT_LOOP",
+		Priority:    issue.Critical,
+		Category:    CategoryStatus,
+		Title:       "Redirect loop",
+		Description: "Redirect loops are a couple of URLs that redirect to each other creating a never ending loop of redirects.",
+	})
+
+	// H1-H6 tags have wrong order
+	ErrorNotValidHeadings = register(models.IssueType{
+		Id:          24,
+		Code:        "ERROR_NOT_VALID_HEADINGS",
+		Priority:    issue.Alert,
+		Category:    CategoryHeadings,
+		Title:       "Not valid headings order",
+		Description: "The heading tags in this pages don't follow the correct order. Change the H tags so they start with H1 and follow the correct order.",
+	})
+
+	// Hreflang to non canonical page
+	ErrorHreflangToNonCanonical = register(models.IssueType{
+		Id:          25,
+		Code:        "ERROR_HREFLANG_TO_NON_CANONICAL",
+		Priority:    issue.Alert,
+		Category:    CategoryHreflang,
+		Title:       "Hreflang to non canonical page",
+		Description: "Hreflang tags tell search engines where to find content in specific languages, linking hreflang tags to non canonical pages confuses search engines.",
+	})
+
+	// Nofollow links to indexable pages
+	ErrorInternalNoFollowIndexable = register(models.IssueType{
+		Id:          26,
+		Code:        "ERROR_NOFOLLOW_INDEXABLE",
+		Priority:    issue.Alert,
+		Category:    CategoryLinks,
+		Title:       "Internal nofollow links to indexable pages",
+		Description: "Internal links using the nofollow attribute tell search engines to not crawl that link. For it to work properly the destination URL should use the noindex tag, otherwise search engines still can index the linked URL.",
+	})
+
+	// Page using the noindex attribute
+	ErrorNoIndexable = register(models.IssueType{
+		Id:          27,
+		Code:        "ERROR_NO_INDEXABLE",
+		Priority:    issue.Warning,
+		Category:    CategoryIndexability,
+		Title:       "Non indexable pages",
+		Description: "Pages using the noindex attribute won't be indexed by search engines, this is usually added to non important pages that we don't wan't to appear in search results. It may be worth reviewing them to make sure important pages are not using this attribute.",
+	})
+
+	// Hreflang to a non indexable page
+	ErrorHreflangNoindexable = register(models.IssueType{
+		Id:          28,
+		Code:        "ERROR_HREFLANG_NO_INDEXABLE",
+		Priority:    issue.Warning,
+		Category:    CategoryHreflang,
+		Title:       "Hreflang to a non indexable page",
+		Description: "Hreflangs should link to indexable pages, linking them to pages using the noindex attribute will confuse search engines.",
+	})
+
+	// Blocked by robots.txt
+	ErrorBlocked = register(models.IssueType{
+		Id:          29,
+		Code:        "ERROR_BLOCKED",
+		Priority:    issue.Warning,
+		Category:    CategoryIndexability,
+		Title:       "Blocked by robots.txt",
+		Description: "Pages blocked by robots.txt won't be crawled by search engines. Keep an eye on this list to make sure important pages are not being blocked.",
+	})
+
+	// Orphan pages
+	ErrorOrphan = register(models.IssueType{
+		Id:          30,
+		Code:        "ERROR_ORPHAN",
+		Priority:    issue.Warning,
+		Category:    CategoryLinks,
+		Title:       "Orphan pages",
+		Description: "Pages that are not linked anywhere in your site, this means no visitors can access to it and may lead to lead to crawl wastage.",
+	})
+
+	// No index pages included in the sitemap
+	ErrorSitemapNoIndex = register(models.IssueType{
+		Id:          31,
+		Code:        "NO_INDEX_IN_SITEMAP",
+		Priority:    issue.Alert,
+		Category:    CategoryIndexability,
+		Title:       "Non-indexable pages are included in the sitemap",
+		Description: "Non-indexable pages should not be included in a sitemap, as this sends a mixed signal to the search engines. They can also slow down the crawling process and lead to incorrect indexing.",
+	})
+
+	// Pages included in the sitemap that are blocked in robots.txt
+	ErrorSitemapBlocked = register(models.IssueType{
+		Id:          32,
+		Code:        "BLOCKED_IN_SITEMAP",
+		Priority:    issue.Alert,
+		Category:    CategoryIndexability,
+		Title:       "Blocked pages are included in the sitemap",
+		Description: "Pages blocked by the robots.txt file should not be included in the sitemap, as this sends a mixed signal to the search engines. They can also slow down the crawling process and lead to incorrect indexing.",
+	})
+
+	// Non canonical pages included in the sitemap
+	ErrorSitemapNonCanonical = register(models.IssueType{
+		Id:          33,
+		Code:        "NON_CANONICAL_IN_SITEMAP",
+		Priority:    issue.Alert,
+		Category:    CategoryIndexability,
+		Title:       "Non-canonical pages included in the sitemap",
+		Description: "Non-canonical pages should not be included in a sitemap as they can mislead search engines, waste time and resources, and lead to incorrect indexing.",
+	})
+
+	// Pages with follow and nofollow incoming links
+	ErrorIncomingFollowNofollow = register(models.IssueType{
+		Id:          34,
+		Code:        "INCOMING_FOLLOW_NOFOLLOW",
+		Priority:    issue.Alert,
+		Category:    CategoryLinks,
+		Title:       "Incoming follow and nofollow links",
+		Description: "Having pages with internal incoming follow and nofollow links can dilute the authority and relevance signals, confusing search engines and making it less likely to rank high in search engine results.",
+	})
+
+	// Pages with invalid lang attribute
+	ErrorInvalidLanguage = register(models.IssueType{
+		Id:          35,
+		Code:        "INVALID_LANG",
+		Priority:    issue.Alert,
+		Category:    CategoryLanguage,
+		Title:       "Pages with invalid lang attribute",
+		Description: "Pages with an invalid lang attribute can cause problems for both search engines and users. Search engines may have trouble identifying the language of the content, while users may struggle with understanding it or using assistive technologies.",
+	})
+
+	// Pages using http scheme instead of https
+	ErrorHTTPScheme = register(models.IssueType{
+		Id:          36,
+		Code:        "HTTP_SCHEME",
+		Priority:    issue.Critical,
+		Category:    CategorySecurity,
+		Title:       "Pages using the HTTP scheme",
+		Description: "Pages using HTTP instead of HTTPS. Using HTTPS is important as it can provide a more secure and trustworthy website, which can lead to improved user engagement and search engine rankings.",
+	})
+
+	// Pages with no outgoing internal or external links
+	ErrorDeadend = register(models.IssueType{
+		Id:          37,
+		Code:        "DEAD_END",
+		Priority:    issue.Warning,
+		Category:    CategoryLinks,
+		Title:       "Dead-end pages",
+		Description: "A dead-end page is a webpage that doesn't have any links pointing to other pages. This means that users and search engines have no way to navigate beyond the current page, making it difficult to explore the site and affecting both user experience and site crawlability.",
+	})
+
+	// Pages that are canonicalized to non-indexable pages
+	ErrorCanonicalizedToNonIndexable = register(models.IssueType{
+		Id:          38,
+		Code:        "ERROR_CANONICALIZED_NON_INDEXABLE",
+		Priority:    issue.Warning,
+		Category:    CategoryCanonical,
+		Title:       "Canonicalized to non-indexable",
+		Description: "Pages canonicalized to other non-indexable pages. This essentially tells search engines that the non-indexable page is the preferred version of the content, which can lead to confusion and make the content less likely to be discovered by users.",
+	})
+
+	// Pages that have hreflang links to other redirected pages
+	ErrorHreflangToRedirect = register(models.IssueType{
+		Id:          39,
+		Code:        "ERROR_HREFLANG_REDIRECT",
+		Priority:    issue.Alert,
+		Category:    CategoryHreflang,
+		Title:       "Hreflang to redirect",
+		Description: "Pages that have hreflang links to pages that are redirected with status codes in the 30x range. This it can create confusion for search engines that may have difficulty understanding the relationship between the original page and the redirected page.",
+	})
+
+	// Pages that are canonicalized to other redirected pages
+	ErrorCanonicalizedToRedirect = register(models.IssueType{
+		Id:          40,
+		Code:        "ERROR_CANONICAL_REDIRECT",
+		Priority:    issue.Alert,
+		Category:    CategoryCanonical,
+		Title:       "Canonicalized to redirect",
+		Description: "Pages that are canonicalized to redirected URLs instead of pointing to the actual preferred version of the page. This can confuse search engines trying to navigate and understand your website.",
+	})
+
+	// Pages that have hreflang links to error pages
+	ErrorHreflangToError = register(models.IssueType{
+		Id:          41,
+		Code:        "ERROR_HREFLANG_ERROR",
+		Priority:    issue.Alert,
+		Category:    CategoryHreflang,
+		Title:       "Hreflang to error",
+		Description: "Pages that have hreflang tags pointing to URLs that are throwing errors with status codes in the 40x or 50x range. This makes it impossible for search engines to properly index different versions of your page, which may affect your ranking in search engines.",
+	})
+
+	// Pages that are canonicalized to error pages
+	ErrorCanonicalizedToError = register(models.IssueType{
+		Id:          42,
+		Code:        "ERROR_CANONICAL_ERROR",
+		Priority:    issue.Alert,
+		Category:    CategoryCanonical,
+		Title:       "Canonicalized to error",
+		Description: "Pages that are canonicalized to URLs that are throwing errors with status codes in the 40x or 50x range. This can confuse search engines that will not be able to crawl your preferred version of the page.",
+	})
+
+	// Pages with images, scripts or styles returning 40x or 50x errors
+	ErrorBrokenResources = register(models.IssueType{
+		Id:          43,
+		Code:        "BROKEN_RESOURCES",
+		Priority:    issue.Alert,
+		Category:    CategoryStatus,
+		Title:       "Broken resources",
+		Description: "Pages that load images, scripts or styles returning errors with status codes in the 40x or 50x range. Broken resources can make pages render incorrectly and waste the search engine's crawl budget.",
+	})
+
+	// Pages with images, scripts or styles that are redirected
+	ErrorRedirectedResources = register(models.IssueType{
+		Id:          44,
+		Code:        "REDIRECTED_RESOURCES",
+		Priority:    issue.Warning,
+		Category:    CategoryStatus,
+		Title:       "Redirected resources",
+		Description: "Pages that load images, scripts or styles that are redirected with status codes in the 30x range. Each redirect adds an extra request, slowing down the page load. Update the resource URLs so they point to the final destination.",
+	})
+
+	// Pages with external links returning errors or not responding
+	ErrorExternalLinkBroken = register(models.IssueType{
+		Id:          45,
+		Code:        "EXTERNAL_LINK_BROKEN",
+		Priority:    issue.Alert,
+		Category:    CategoryLinks,
+		Title:       "Broken external links",
+		Description: "Pages linking to external URLs that return errors with status codes in the 40x or 50x range, or that could not be reached at all. Broken external links hurt the user experience and can be seen as a sign of a poorly maintained site.",
+	})
+
+	// Pages with external links that are redirected
+	ErrorExternalLinkRedirect = register(models.IssueType{
+		Id:          46,
+		Code:        "EXTERNAL_LINK_REDIRECT",
+		Priority:    issue.Warning,
+		Category:    CategoryLinks,
+		Title:       "Redirected external links",
+		Description: "Pages linking to external URLs that are redirected with status codes in the 30x range. Update the links so they point to the final destination URL.",
+	})
+
+	// Pages with conflicting robots directives
+	ErrorRobotsConflict = register(models.IssueType{
+		Id:          47,
+		Code:        "ROBOTS_CONFLICT",
+		Priority:    issue.Alert,
+		Category:    CategoryIndexability,
+		Title:       "Conflicting robots directives",
+		Description: "Pages with contradicting robots directives, for instance \"index\" and \"noindex\" in the robots meta tags or in the X-Robots-Tag header. Search engines will apply the most restrictive directive, which may not be the intended one.",
+	})
+
+	// Non-HTML files with noindex in the X-Robots-Tag header
+	ErrorNoindexNonHTML = register(models.IssueType{
+		Id:          48,
+		Code:        "NOINDEX_NON_HTML",
+		Priority:    issue.Warning,
+		Category:    CategoryIndexability,
+		Title:       "Noindex in non-HTML files",
+		Description: "Non-HTML files, such as PDF documents, with the noindex directive in the X-Robots-Tag header. This directive is easy to overlook because it is not visible in the file contents. Make sure these files are not meant to be indexed.",
+	})
+
+	// Pages with structured data that can't be parsed
+	ErrorInvalidStructuredData = register(models.IssueType{
+		Id:          49,
+		Code:        "INVALID_STRUCTURED_DATA",
+		Priority:    issue.Alert,
+		Category:    CategoryStructuredData,
+		Title:       "Invalid structured data",
+		Description: "Pages with structured data that can not be parsed, such as JSON-LD scripts with syntax errors, microdata items with an itemtype that is not an absolute URL or RDFa types without a vocabulary. Search engines ignore invalid markup, so these pages are not eligible for rich results.",
+	})
+
+	// Pages with structured data missing required properties
+	ErrorStructuredDataMissingRequired = register(models.IssueType{
+		Id:          50,
+		Code:        "STRUCTURED_DATA_MISSING_REQUIRED",
+		Priority:    issue.Alert,
+		Category:    CategoryStructuredData,
+		Title:       "Structured data missing required properties",
+		Description: "Pages with Product, Article, BreadcrumbList, FAQPage or Organization structured data missing some of the properties required by search engines. Products also need at least one of offers, review or aggregateRating. Items without the required properties are not eligible for rich results.",
+	})
+
+	// Pages with structured data missing recommended properties
+	ErrorStructuredDataMissingRecommended = register(models.IssueType{
+		Id:          51,
+		Code:        "STRUCTURED_DATA_MISSING_RECOMMENDED",
+		Priority:    issue.Warning,
+		Category:    CategoryStructuredData,
+		Title:       "Structured data missing recommended properties",
+		Description: "Pages with Product, Article or Organization structured data missing some of the recommended properties. Adding them provides more information to search engines and can improve how the page is displayed in search results.",
+	})
+
+	// Pages without the og:title meta tag
+	ErrorOGTitleMissing = register(models.IssueType{
+		Id:          52,
+		Code:        "OG_TITLE_MISSING",
+		Priority:    issue.Warning,
+		Category:    CategorySocial,
+		Title:       "Missing og:title",
+		Description: "Indexable pages without the og:title meta tag. Social networks use it as the title of the shared link, otherwise they try to guess it from the page contents.",
+	})
+
+	// Pages without the og:image meta tag
+	ErrorOGImageMissing = register(models.IssueType{
+		Id:          53,
+		Code:        "OG_IMAGE_MISSING",
+		Priority:    issue.Warning,
+		Category:    CategorySocial,
+		Title:       "Missing og:image",
+		Description: "Indexable pages without the og:image meta tag. Links shared without an image are less visible and get less clicks in social networks.",
+	})
+
+	// Pages with an og:url different from the canonical URL
+	ErrorOGURLCanonicalMismatch = register(models.IssueType{
+		Id:          54,
+		Code:        "OG_URL_CANONICAL_MISMATCH",
+		Priority:    issue.Warning,
+		Category:    CategorySocial,
+		Title:       "og:url does not match the canonical",
+		Description: "Pages where the og:url meta tag is different from the canonical URL. Social networks use the og:url to group the shares of a page, so it should point to the same URL as the canonical.",
+	})
+
+	// Pages with a relative og:image URL
+	ErrorOGImageRelative = register(models.IssueType{
+		Id:          55,
+		Code:        "OG_IMAGE_RELATIVE",
+		Priority:    issue.Alert,
+		Category:    CategorySocial,
+		Title:       "Relative og:image URL",
+		Description: "Pages with a relative URL in the og:image meta tag. Social networks require absolute URLs and will not display relative images.",
+	})
+
+	// Pages with an og:image URL returning an error
+	ErrorOGImageBroken = register(models.IssueType{
+		Id:          56,
+		Code:        "OG_IMAGE_BROKEN",
+		Priority:    issue.Alert,
+		Category:    CategorySocial,
+		Title:       "Broken og:image",
+		Description: "Pages with an og:image URL that returns an error. The image will not be displayed when the page is shared in social networks.",
+	})
+
+	// Pages with more than one H1 heading
+	ErrorMultipleH1 = register(models.IssueType{
+		Id:          57,
+		Code:        "MULTIPLE_H1",
+		Priority:    issue.Warning,
+		Category:    CategoryHeadings,
+		Title:       "Multiple H1 headings",
+		Description: "Pages with more than one H1 heading. The H1 heading should describe the main topic of the page, using several of them makes the page structure less clear for users and search engines.",
+	})
+
+	// Pages with an H1 heading identical to the title
+	ErrorH1EqualsTitle = register(models.IssueType{
+		Id:          58,
+		Code:        "H1_EQUALS_TITLE",
+		Priority:    issue.Warning,
+		Category:    CategoryHeadings,
+		Title:       "H1 identical to the title",
+		Description: "Pages where the H1 heading is exactly the same as the page title. Using a different H1 is an opportunity to describe the page with other relevant words.",
+	})
+
+	// Pages with empty headings
+	ErrorEmptyHeadings = register(models.IssueType{
+		Id:          59,
+		Code:        "EMPTY_HEADINGS",
+		Priority:    issue.Warning,
+		Category:    CategoryHeadings,
+		Title:       "Empty headings",
+		Description: "Pages with heading tags that don't contain any text. Empty headings break the page outline and can confuse screen readers and search engines.",
+	})
+
+	// Pages with duplicate or near duplicate main content
+	ErrorDuplicatedContent = register(models.IssueType{
+		Id:          60,
+		Code:        "DUPLICATED_CONTENT",
+		Priority:    issue.Alert,
+		Category:    CategoryContent,
+		Title:       "Duplicate content",
+		Description: "Pages with the same or a very similar main content. Search engines may pick a different URL than the one you want to rank; select a canonical URL for each cluster in the Duplicate Content view and point the canonical tag of the duplicates to it.",
+	})
+
+	// Pages with a low readability score
+	ErrorLowReadability = register(models.IssueType{
+		Id:          61,
+		Code:        "LOW_READABILITY",
+		Priority:    issue.Warning,
+		Category:    CategoryContent,
+		Title:       "Low readability",
+		Description: "Pages with a readability score below 30, which means the content is very difficult to read. Long sentences and long words make the text harder to understand for your users. The score is calculated with a Flesch reading ease formula adapted to the page's language.",
+	})
+
+	// Pages with links without anchor text
+	ErrorEmptyAnchorText = register(models.IssueType{
+		Id:          62,
+		Code:        "EMPTY_ANCHOR_TEXT",
+		Priority:    issue.Warning,
+		Category:    CategoryLinks,
+		Title:       "Links without anchor text",
+		Description: "Pages with links that have no anchor text. Search engines use the anchor text to understand what the linked page is about, and screen readers need it to describe the link. In image links the alt text of the image is used as anchor text.",
+	})
+
+	// Pages with next or prev links to error pages or without the reciprocal link
+	ErrorBrokenPagination = register(models.IssueType{
+		Id:          63,
+		Code:        "BROKEN_PAGINATION",
+		Priority:    issue.Alert,
+		Category:    CategoryLinks,
+		Title:       "Broken pagination",
+		Description: "Pages with rel=\"next\" or rel=\"prev\" links to pages that return an error or redirect, or to pages that don't link back with the opposite relation. Broken sequences make it harder for search engines to understand and crawl the paginated series.",
+	})
+
+	// AMP pages whose canonical does not point back to the page linking to them
+	ErrorAmpCanonicalMismatch = register(models.IssueType{
+		Id:          64,
+		Code:        "AMP_CANONICAL_MISMATCH",
+		Priority:    issue.Alert,
+		Category:    CategoryCanonical,
+		Title:       "AMP canonical does not point back",
+		Description: "AMP pages with a canonical URL that doesn't point to the page linking to them with rel=\"amphtml\". The AMP version must use the non-AMP page as its canonical for the pages to be paired.",
+	})
+
+	// Pages with mobile alternates whose canonical does not point back to them
+	ErrorMobileAlternateNotReciprocal = register(models.IssueType{
+		Id:          65,
+		Code:        "MOBILE_ALTERNATE_NOT_RECIPROCAL",
+		Priority:    issue.Alert,
+		Category:    CategoryLinks,
+		Title:       "Mobile alternate not reciprocal",
+		Description: "Pages with a mobile alternate URL, such as an m-dot page, whose canonical doesn't point back to them. Separate mobile URLs need a rel=\"alternate\" link in the desktop page and a canonical pointing back in the mobile page.",
+	})
+
+	// Paginated pages canonicalized to the first page of the series
+	ErrorPaginationCanonicalFirstPage = register(models.IssueType{
+		Id:          66,
+		Code:        "PAGINATION_CANONICAL_FIRST_PAGE",
+		Priority:    issue.Warning,
+		Category:    CategoryCanonical,
+		Title:       "Paginated pages canonicalized to the first page",
+		Description: "Paginated pages with a canonical URL pointing to the first page of the series. Search engines may not index the content linked from the following pages. Each page in the series should be self-canonical.",
+	})
+
+	// Pages with images without width or height attributes
+	ErrorImageMissingDimensions = register(models.IssueType{
+		Id:          67,
+		Code:        "IMAGE_MISSING_DIMENSIONS",
+		Priority:    issue.Warning,
+		Category:    CategoryImages,
+		Title:       "Images without dimensions",
+		Description: "Pages with images that don't have width and height attributes. Without dimensions the browser can't reserve the space of the image before it loads, causing layout shifts that hurt the user experience and the Cumulative Layout Shift metric.",
+	})
+
+	// Pages with images larger than the project's size limit
+	ErrorOversizedImages = register(models.IssueType{
+		Id:          68,
+		Code:        "OVERSIZED_IMAGES",
+		Priority:    issue.Alert,
+		Category:    CategoryImages,
+		Title:       "Oversized images",
+		Description: "Pages with images larger than the maximum image size set in the project options. Large images slow down the page load. Compress or resize them, and consider using srcset to serve smaller images to smaller screens.",
+	})
+
+	// Pages with images that are not in a modern format such as WebP or AVIF
+	ErrorNonModernImageFormat = register(models.IssueType{
+		Id:          69,
+		Code:        "NON_MODERN_IMAGE_FORMAT",
+		Priority:    issue.Warning,
+		Category:    CategoryImages,
+		Title:       "Images not in modern formats",
+		Description: "Pages with JPEG, PNG, GIF or other legacy image formats. Modern formats such as WebP and AVIF provide better compression, making the images smaller and the pages faster.",
+	})
+
+	// Pages with images with a very long alt text
+	ErrorAltTextTooLong = register(models.IssueType{
+		Id:          70,
+		Code:        "ALT_TEXT_TOO_LONG",
+		Priority:    issue.Warning,
+		Category:    CategoryImages,
+		Title:       "Alt text too long",
+		Description: "Pages with images that have an alt text longer than 125 characters. Screen readers may cut off long alt texts. Keep them short and descriptive.",
+	})
+
+	// Pages with images whose alt text is the image's file name
+	ErrorAltTextFilename = register(models.IssueType{
+		Id:          71,
+		Code:        "ALT_TEXT_FILENAME",
+		Priority:    issue.Warning,
+		Category:    CategoryImages,
+		Title:       "Alt text is the file name",
+		Description: "Pages with images whose alt text is the file name of the image. File names don't describe the image to users of screen readers or to search engines.",
+	})
+
+	// Pages without viewport meta tag
+	ErrorMissingViewport = register(models.IssueType{
+		Id:          72,
+		Code:        "MISSING_VIEWPORT",
+		Priority:    issue.Alert,
+		Category:    CategoryPageSetup,
+		Title:       "Missing viewport",
+		Description: "Pages without the viewport meta tag. Mobile browsers render these pages with the width of a desktop screen, making the text too small to read. Add <meta name=\"viewport\" content=\"width=device-width, initial-scale=1\"> to the head of the page.",
+	})
+
+	// Pages with a viewport that is not responsive or disables zooming
+	ErrorInvalidViewport = register(models.IssueType{
+		Id:          73,
+		Code:        "INVALID_VIEWPORT",
+		Priority:    issue.Warning,
+		Category:    CategoryPageSetup,
+		Title:       "Incorrect viewport",
+		Description: "Pages with a viewport meta tag that doesn't set the width to device-width, or that prevents users from zooming in with user-scalable=no or a maximum-scale lower than 2. Disabling zoom is an accessibility problem for users with low vision.",
+	})
+
+	// Pages with different charsets in the HTTP header and the HTML
+	ErrorCharsetMismatch = register(models.IssueType{
+		Id:          74,
+		Code:        "CHARSET_MISMATCH",
+		Priority:    issue.Alert,
+		Category:    CategoryPageSetup,
+		Title:       "Charset mismatch",
+		Description: "Pages with a charset in the Content-Type HTTP header that is different from the charset declared in the HTML. Browsers use the header charset, so the text may be displayed with wrong characters.",
+	})
+
+	// Pages without favicon link
+	ErrorMissingFavicon = register(models.IssueType{
+		Id:          75,
+		Code:        "MISSING_FAVICON",
+		Priority:    issue.Warning,
+		Category:    CategoryPageSetup,
+		Title:       "Missing favicon",
+		Description: "Pages without a favicon link and without a favicon.ico file in the root of the site. The favicon is shown in the browser tabs, bookmarks and in the mobile search results. Add a <link rel=\"icon\"> tag to the head of the page.",
+	})
+
+	// Pages with SEO tags after an element that closes the head early
+	ErrorHeadBreakingElement = register(models.IssueType{
+		Id:          76,
+		Code:        "HEAD_BREAKING_ELEMENT",
+		Priority:    issue.Critical,
+		Category:    CategoryPageSetup,
+		Title:       "SEO tags after a head-breaking element",
+		Description: "Pages with an element that is not allowed in the head, such as a div, an img or some text, followed by SEO tags like the canonical, hreflang or robots tags. Browsers and search engines close the head when they find that element, so the tags that come after it are moved to the body and ignored. Move the element to the body or the SEO tags above it.",
+	})
+
+	// Pages with the same id attribute in more than one element
+	ErrorDuplicateIds = register(models.IssueType{
+		Id:          77,
+		Code:        "DUPLICATE_IDS",
+		Priority:    issue.Warning,
+		Category:    CategoryPageSetup,
+		Title:       "Duplicate id attributes",
+		Description: "Pages with the same id attribute value in more than one element. The id must be unique in the page, otherwise links to fragments, labels, scripts and assistive technologies may target the wrong element.",
+	})
+
+	// Pages with more than one title tag
+	ErrorMultipleTitleTags = register(models.IssueType{
+		Id:          78,
+		Code:        "MULTIPLE_TITLE_TAGS",
+		Priority:    issue.Alert,
+		Category:    CategoryTitles,
+		Title:       "Multiple title tags",
+		Description: "Pages with more than one title tag. Search engines may use any of them as the page title, so make sure each page has a single title tag in the head.",
+	})
+
+	// Pages with more than one canonical link tag
+	ErrorMultipleCanonicalTags = register(models.IssueType{
+		Id:          79,
+		Code:        "MULTIPLE_CANONICAL_TAGS",
+		Priority:    issue.Alert,
+		Category:    CategoryCanonical,
+		Title:       "Multiple canonical tags",
+		Description: "Pages with more than one canonical link tag. When a page has several canonical tags search engines may ignore all of them. Make sure each page has a single canonical tag in the head.",
+	})
+
+	// Pages with form fields without a label
+	ErrorInputWithoutLabel = register(models.IssueType{
+		Id:          80,
+		Code:        "INPUT_WITHOUT_LABEL",
+		Priority:    issue.Accessibility,
+		Category:    CategoryAccessibility,
+		Title:       "Form fields without label",
+		Description: "Pages with form fields that don't have a label element, an aria-label or a title. Screen readers can't tell users what information is expected in the field. A placeholder is not a replacement for a label.",
+	})
+
+	// Pages with buttons without an accessible name
+	ErrorButtonWithoutName = register(models.IssueType{
+		Id:          81,
+		Code:        "BUTTON_WITHOUT_NAME",
+		Priority:    issue.Accessibility,
+		Category:    CategoryAccessibility,
+		Title:       "Buttons without accessible name",
+		Description: "Pages with buttons that have no text, value, alt text or aria-label. Users of screen readers will only hear \"button\" without knowing what it does.",
+	})
+
+	// Pages with links without an accessible name
+	ErrorLinkWithoutName = register(models.IssueType{
+		Id:          82,
+		Code:        "LINK_WITHOUT_NAME",
+		Priority:    issue.Accessibility,
+		Category:    CategoryAccessibility,
+		Title:       "Links without accessible name",
+		Description: "Pages with links that have no text, alt text, aria-label, aria-labelledby or title, such as links containing only an icon or an image without alt text. Screen readers announce these links without any hint of their destination.",
+	})
+
+	// Pages with elements with a positive tabindex
+	ErrorPositiveTabindex = register(models.IssueType{
+		Id:          83,
+		Code:        "POSITIVE_TABINDEX",
+		Priority:    issue.Accessibility,
+		Category:    CategoryAccessibility,
+		Title:       "Positive tabindex",
+		Description: "Pages with elements that have a tabindex greater than zero. A positive tabindex changes the natural focus order of the page, which is confusing for keyboard users. Use tabindex=\"0\" or \"-1\" instead.",
+	})
+
+	// Pages with iframes without a title
+	ErrorIframeWithoutTitle = register(models.IssueType{
+		Id:          84,
+		Code:        "IFRAME_WITHOUT_TITLE",
+		Priority:    issue.Accessibility,
+		Category:    CategoryAccessibility,
+		Title:       "Iframes without title",
+		Description: "Pages with iframes without a title attribute. Screen readers use the title to describe the content of the iframe to their users.",
+	})
+
+	// PDF documents without a title
+	ErrorPDFMissingTitle = register(models.IssueType{
+		Id:          85,
+		Code:        "PDF_MISSING_TITLE",
+		Priority:    issue.Alert,
+		Category:    CategoryPDF,
+		Title:       "PDF without title",
+		Description: "PDF documents without a title in their metadata. Search engines show the title in the results, so documents without one are displayed with the file name or a text snippet instead.",
+	})
+
+	// PDF documents without a language
+	ErrorPDFMissingLang = register(models.IssueType{
+		Id:          86,
+		Code:        "PDF_MISSING_LANG",
+		Priority:    issue.Warning,
+		Category:    CategoryPDF,
+		Title:       "PDF without language",
+		Description: "PDF documents that do not specify their language in the document catalog or in the Content-Language header. Screen readers and search engines use it to process the text correctly.",
+	})
+
+	// PDF documents without extractable text
+	ErrorPDFWithoutText = register(models.IssueType{
+		Id:          87,
+		Code:        "PDF_WITHOUT_TEXT",
+		Priority:    issue.Warning,
+		Category:    CategoryPDF,
+		Title:       "PDF without text",
+		Description: "PDF documents with pages but without any extractable text, usually scanned documents. Their content cannot be indexed by search engines unless it is made searchable with OCR.",
+	})
+
+	// PDF documents with links to broken pages
+	ErrorPDFBrokenLinks = register(models.IssueType{
+		Id:          88,
+		Code:        "PDF_BROKEN_LINKS",
+		Priority:    issue.Alert,
+		Category:    CategoryPDF,
+		Title:       "PDF with broken links",
+		Description: "PDF documents with links to internal or external pages returning errors with status codes in the 40x or 50x range, or timing out.",
+	})
+
+	// Pages with different canonicals in the HTML and the HTTP headers
+	ErrorConflictingCanonicals = register(models.IssueType{
+		Id:          89,
+		Code:        "CONFLICTING_CANONICALS",
+		Priority:    issue.Critical,
+		Category:    CategoryCanonical,
+		Title:       "Conflicting canonicals",
+		Description: "Pages with a canonical in the Link HTTP header that is different from the canonical link tag in the HTML or from another canonical in the headers. Search engines may ignore both canonicals when they conflict.",
+	})
+
+	// Pages with a canonical pointing to another domain
+	ErrorCrossDomainCanonical = register(models.IssueType{
+		Id:          90,
+		Code:        "CROSS_DOMAIN_CANONICAL",
+		Priority:    issue.Warning,
+		Category:    CategoryCanonical,
+		Title:       "Cross-domain canonical",
+		Description: "Pages with a canonical URL pointing to a different domain. Subdomains of the same domain are not reported. Make sure the content is meant to be indexed under the other domain.",
+	})
+
+	// Pages with a canonical URL with query parameters or a fragment
+	ErrorCanonicalWithParameters = register(models.IssueType{
+		Id:          91,
+		Code:        "CANONICAL_WITH_PARAMETERS",
+		Priority:    issue.Warning,
+		Category:    CategoryCanonical,
+		Title:       "Canonical with parameters",
+		Description: "Pages with a canonical URL containing query parameters or a fragment. Canonical URLs should point to the clean version of the page.",
+	})
+
+	// Pages canonicalized to pages that are canonicalized to a third URL
+	ErrorCanonicalChain = register(models.IssueType{
+		Id:          92,
+		Code:        "CANONICAL_CHAIN",
+		Priority:    issue.Alert,
+		Category:    CategoryCanonical,
+		Title:       "Canonical chain",
+		Description: "Pages canonicalized to a page that is canonicalized to a different URL. The page in the middle of the chain is also reported as canonicalized to non canonical. Canonicals should point directly to the final canonical URL.",
+	})
+
+	// Pages with hreflang values that are not valid language and region codes
+	ErrorInvalidHreflangCode = register(models.IssueType{
+		Id:          93,
+		Code:        "INVALID_HREFLANG_CODE",
+		Priority:    issue.Alert,
+		Category:    CategoryHreflang,
+		Title:       "Invalid hreflang code",
+		Description: "Pages with hreflang values that are not a valid ISO 639-1 language code optionally followed by an ISO 3166-1 alpha-2 region code, such as en-UK instead of en-GB. Search engines ignore hreflangs with invalid codes.",
+	})
+
+	// Pages with hreflangs that don't reference the page itself
+	ErrorHreflangMissingSelfReference = register(models.IssueType{
+		Id:          94,
+		Code:        "HREFLANG_MISSING_SELF_REFERENCE",
+		Priority:    issue.Warning,
+		Category:    CategoryHreflang,
+		Title:       "Missing self-referencing hreflang",
+		Description: "Pages with hreflang annotations that do not include an hreflang pointing to the page itself.",
+	})
+
+	// Pages with hreflangs without an x-default hreflang
+	ErrorHreflangMissingXDefault = register(models.IssueType{
+		Id:          95,
+		Code:        "HREFLANG_MISSING_X_DEFAULT",
+		Priority:    issue.Warning,
+		Category:    CategoryHreflang,
+		Title:       "Missing x-default hreflang",
+		Description: "Pages with hreflang annotations without an x-default hreflang, which tells search engines the page to show to users whose language is not in the annotations.",
+	})
+
+	// Pages with the same hreflang language pointing to different URLs
+	ErrorHreflangDuplicateLang = register(models.IssueType{
+		Id:          96,
+		Code:        "HREFLANG_DUPLICATE_LANG",
+		Priority:    issue.Alert,
+		Category:    CategoryHreflang,
+		Title:       "Duplicate hreflang languages",
+		Description: "Pages with the same hreflang language pointing to different URLs. Search engines may ignore the conflicting annotations.",
+	})
+
+	// Pages with hreflangs to pages with a different language
+	ErrorHreflangLangMismatch = register(models.IssueType{
+		Id:          97,
+		Code:        "HREFLANG_LANG_MISMATCH",
+		Priority:    issue.Warning,
+		Category:    CategoryHreflang,
+		Title:       "Hreflang language mismatch",
+		Description: "Pages with hreflang annotations pointing to pages whose language is different from the hreflang language.",
+	})
+
+	// HTTPS pages without the Strict-Transport-Security header
+	ErrorMissingHSTS = register(models.IssueType{
+		Id:          98,
+		Code:        "MISSING_HSTS",
+		Priority:    issue.Warning,
+		Category:    CategorySecurity,
+		Title:       "Missing HSTS header",
+		Description: "HTTPS pages without the Strict-Transport-Security header. Without it, browsers may connect to the site over insecure HTTP before being redirected.",
+	})
+
+	// HTTPS pages with a Strict-Transport-Security header without a valid max-age
+	ErrorInvalidHSTS = register(models.IssueType{
+		Id:          99,
+		Code:        "INVALID_HSTS",
+		Priority:    issue.Alert,
+		Category:    CategorySecurity,
+		Title:       "Invalid HSTS header",
+		Description: "HTTPS pages with a Strict-Transport-Security header without a max-age directive greater than 0. Browsers ignore HSTS headers without a valid max-age.",
+	})
+
+	// Pages without the Content-Security-Policy header
+	ErrorMissingCSP = register(models.IssueType{
+		Id:          100,
+		Code:        "MISSING_CSP",
+		Priority:    issue.Warning,
+		Category:    CategorySecurity,
+		Title:       "Missing Content-Security-Policy",
+		Description: "Pages without the Content-Security-Policy header, which restricts the resources the page can load and helps to mitigate cross-site scripting attacks.",
+	})
+
+	// Pages with a Content-Security-Policy allowing unsafe-inline
+	ErrorCSPUnsafeInline = register(models.IssueType{
+		Id:          101,
+		Code:        "CSP_UNSAFE_INLINE",
+		Priority:    issue.Warning,
+		Category:    CategorySecurity,
+		Title:       "CSP allows unsafe-inline",
+		Description: "Pages with a Content-Security-Policy that allows inline scripts or styles with unsafe-inline and without nonces or hashes, which defeats most of the protection against cross-site scripting.",
+	})
+
+	// Pages without X-Frame-Options or the frame-ancestors directive
+	ErrorMissingXFrameOptions = register(models.IssueType{
+		Id:          102,
+		Code:        "MISSING_X_FRAME_OPTIONS",
+		Priority:    issue.Warning,
+		Category:    CategorySecurity,
+		Title:       "Missing clickjacking protection",
+		Description: "Pages without an X-Frame-Options header set to DENY or SAMEORIGIN and without the frame-ancestors directive in the Content-Security-Policy, so they can be embedded in other sites.",
+	})
+
+	// Pages without the X-Content-Type-Options nosniff header
+	ErrorMissingXContentTypeOptions = register(models.IssueType{
+		Id:          103,
+		Code:        "MISSING_X_CONTENT_TYPE_OPTIONS",
+		Priority:    issue.Warning,
+		Category:    CategorySecurity,
+		Title:       "Missing X-Content-Type-Options",
+		Description: "Pages without the X-Content-Type-Options header set to nosniff, which prevents browsers from guessing the content type of the responses.",
+	})
+
+	// Pages without a valid Referrer-Policy header
+	ErrorMissingReferrerPolicy = register(models.IssueType{
+		Id:          104,
+		Code:        "MISSING_REFERRER_POLICY",
+		Priority:    issue.Warning,
+		Category:    CategorySecurity,
+		Title:       "Missing Referrer-Policy",
+		Description: "Pages without a Referrer-Policy header with a policy supported by the browsers.",
+	})
+
+	// Pages with the unsafe-url Referrer-Policy
+	ErrorUnsafeReferrerPolicy = register(models.IssueType{
+		Id:          105,
+		Code:        "UNSAFE_REFERRER_POLICY",
+		Priority:    issue.Warning,
+		Category:    CategorySecurity,
+		Title:       "Unsafe Referrer-Policy",
+		Description: "Pages with the unsafe-url Referrer-Policy, which sends the full URL, including the query string, to any site, even over insecure HTTP.",
+	})
+
+	// Pages without the Permissions-Policy header
+	ErrorMissingPermissionsPolicy = register(models.IssueType{
+		Id:          106,
+		Code:        "MISSING_PERMISSIONS_POLICY",
+		Priority:    issue.Warning,
+		Category:    CategorySecurity,
+		Title:       "Missing Permissions-Policy",
+		Description: "Pages without the Permissions-Policy header, which controls the browser features such as the camera or the geolocation that the page and its iframes can use.",
+	})
+
+	// Images, scripts and styles with a short or missing max-age
+	ErrorStaticResourceShortCache = register(models.IssueType{
+		Id:          107,
+		Code:        "STATIC_RESOURCE_SHORT_CACHE",
+		Priority:    issue.Warning,
+		Category:    CategoryCaching,
+		Title:       "Static resources with short cache",
+		Description: "Images, scripts and styles that cannot be cached by the browsers for at least a week because their Cache-Control max-age or Expires headers are missing or too short. These resources are downloaded again on repeat visits.",
+	})
+
+	// HTML pages publicly cacheable that set cookies
+	ErrorPublicCacheWithCookie = register(models.IssueType{
+		Id:          108,
+		Code:        "PUBLIC_CACHE_WITH_COOKIE",
+		Priority:    issue.Alert,
+		Category:    CategoryCaching,
+		Title:       "Publicly cached pages setting cookies",
+		Description: "HTML pages with a public or s-maxage Cache-Control that also send Set-Cookie headers. Shared caches such as CDNs may serve the cookies of a user to other users.",
+	})
+
+	// Responses without ETag or Last-Modified headers
+	ErrorMissingCacheValidators = register(models.IssueType{
+		Id:          109,
+		Code:        "MISSING_CACHE_VALIDATORS",
+		Priority:    issue.Warning,
+		Category:    CategoryCaching,
+		Title:       "Responses without cache validators",
+		Description: "Responses without ETag or Last-Modified headers. Once the cached copy is stale, browsers have to download it again instead of checking if it changed.",
+	})
+
+	// Pages with images without alt attribute that are not decorative
+	ErrorImageWithoutTextAlternative = register(models.IssueType{
+		Id:          110,
+		Code:        "IMAGE_WITHOUT_TEXT_ALTERNATIVE",
+		Priority:    issue.Accessibility,
+		Category:    CategoryAccessibility,
+		Title:       "Images without text alternative",
+		Description: "Pages with images without an alt attribute that are not marked as decorative with an empty alt, role=\"presentation\" or aria-hidden. Screen readers can't describe these images and may read their file name instead.",
+	})
+
+	// Pages without lang attribute in the html element
+	ErrorHTMLWithoutLang = register(models.IssueType{
+		Id:          111,
+		Code:        "HTML_WITHOUT_LANG",
+		Priority:    issue.Accessibility,
+		Category:    CategoryAccessibility,
+		Title:       "Page language not declared",
+		Description: "Pages without a lang attribute in the html element. Screen readers use it to read the content with the right pronunciation, the Content-Language header is not used for this purpose.",
+	})
 )
@@ -0,0 +1,134 @@
+package reporters
+
+import (
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page has
+// form fields without a label or an accessible name.
+func NewInputWithoutLabelReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.Accessibility.InputsWithoutLabel > 0
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorInputWithoutLabel,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page has
+// buttons without an accessible name.
+func NewButtonWithoutNameReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.Accessibility.ButtonsWithoutName > 0
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorButtonWithoutName,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page has
+// links without an accessible name, such as links that only contain an image without alt
+// text.
+func NewLinkWithoutNameReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.Accessibility.LinksWithoutName > 0
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorLinkWithoutName,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page has
+// elements with a tabindex greater than zero, which changes the natural focus order.
+func NewPositiveTabindexReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.Accessibility.PositiveTabindex > 0
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorPositiveTabindex,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page has
+// iframes without a title.
+func NewIframeWithoutTitleReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.Accessibility.IframesWithoutTitle > 0
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorIframeWithoutTitle,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page has
+// images without alt attribute that are not marked as decorative or hidden.
+func NewImageWithoutTextAlternativeReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.Accessibility.ImagesWithoutAlt > 0
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorImageWithoutTextAlternative,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page's html
+// element has no lang attribute.
+func NewHTMLWithoutLangReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.Accessibility.MissingLang
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorHTMLWithoutLang,
+		Callback:  c,
+	}
+}
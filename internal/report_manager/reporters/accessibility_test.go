@@ -0,0 +1,289 @@
+package reporters_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporters"
+)
+
+// Test the InputWithoutLabel reporter with a pageReport without any form field without label.
+// The reporter should not report the issue.
+func TestInputWithoutLabelNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{},
+	}
+
+	reporter := reporters.NewInputWithoutLabelReporter()
+	if reporter.ErrorType != reporter_errors.ErrorInputWithoutLabel {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestInputWithoutLabelNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the InputWithoutLabel reporter with a pageReport with a form field without label.
+// The reporter should report the issue.
+func TestInputWithoutLabelIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{InputsWithoutLabel: 1},
+	}
+
+	reporter := reporters.NewInputWithoutLabelReporter()
+	if reporter.ErrorType != reporter_errors.ErrorInputWithoutLabel {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestInputWithoutLabelIssues: reportsIssue should be true")
+	}
+}
+
+// Test the ButtonWithoutName reporter with a pageReport without any button without name.
+// The reporter should not report the issue.
+func TestButtonWithoutNameNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{},
+	}
+
+	reporter := reporters.NewButtonWithoutNameReporter()
+	if reporter.ErrorType != reporter_errors.ErrorButtonWithoutName {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestButtonWithoutNameNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the ButtonWithoutName reporter with a pageReport with a button without name.
+// The reporter should report the issue.
+func TestButtonWithoutNameIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{ButtonsWithoutName: 1},
+	}
+
+	reporter := reporters.NewButtonWithoutNameReporter()
+	if reporter.ErrorType != reporter_errors.ErrorButtonWithoutName {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestButtonWithoutNameIssues: reportsIssue should be true")
+	}
+}
+
+// Test the LinkWithoutName reporter with a pageReport without any link without name.
+// The reporter should not report the issue.
+func TestLinkWithoutNameNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{},
+	}
+
+	reporter := reporters.NewLinkWithoutNameReporter()
+	if reporter.ErrorType != reporter_errors.ErrorLinkWithoutName {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestLinkWithoutNameNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the LinkWithoutName reporter with a pageReport with a link without name.
+// The reporter should report the issue.
+func TestLinkWithoutNameIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{LinksWithoutName: 1},
+	}
+
+	reporter := reporters.NewLinkWithoutNameReporter()
+	if reporter.ErrorType != reporter_errors.ErrorLinkWithoutName {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestLinkWithoutNameIssues: reportsIssue should be true")
+	}
+}
+
+// Test the PositiveTabindex reporter with a pageReport without any element with positive tabindex.
+// The reporter should not report the issue.
+func TestPositiveTabindexNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{},
+	}
+
+	reporter := reporters.NewPositiveTabindexReporter()
+	if reporter.ErrorType != reporter_errors.ErrorPositiveTabindex {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestPositiveTabindexNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the PositiveTabindex reporter with a pageReport with an element with positive tabindex.
+// The reporter should report the issue.
+func TestPositiveTabindexIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{PositiveTabindex: 1},
+	}
+
+	reporter := reporters.NewPositiveTabindexReporter()
+	if reporter.ErrorType != reporter_errors.ErrorPositiveTabindex {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestPositiveTabindexIssues: reportsIssue should be true")
+	}
+}
+
+// Test the IframeWithoutTitle reporter with a pageReport without any iframe without title.
+// The reporter should not report the issue.
+func TestIframeWithoutTitleNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{},
+	}
+
+	reporter := reporters.NewIframeWithoutTitleReporter()
+	if reporter.ErrorType != reporter_errors.ErrorIframeWithoutTitle {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestIframeWithoutTitleNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the IframeWithoutTitle reporter with a pageReport with an iframe without title.
+// The reporter should report the issue.
+func TestIframeWithoutTitleIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{IframesWithoutTitle: 1},
+	}
+
+	reporter := reporters.NewIframeWithoutTitleReporter()
+	if reporter.ErrorType != reporter_errors.ErrorIframeWithoutTitle {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestIframeWithoutTitleIssues: reportsIssue should be true")
+	}
+}
+
+// Test the ImageWithoutTextAlternative reporter with a pageReport without any image without text alternative.
+// The reporter should not report the issue.
+func TestImageWithoutTextAlternativeNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{},
+	}
+
+	reporter := reporters.NewImageWithoutTextAlternativeReporter()
+	if reporter.ErrorType != reporter_errors.ErrorImageWithoutTextAlternative {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestImageWithoutTextAlternativeNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the ImageWithoutTextAlternative reporter with a pageReport with an image without alt attribute.
+// The reporter should report the issue.
+func TestImageWithoutTextAlternativeIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{ImagesWithoutAlt: 1},
+	}
+
+	reporter := reporters.NewImageWithoutTextAlternativeReporter()
+	if reporter.ErrorType != reporter_errors.ErrorImageWithoutTextAlternative {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestImageWithoutTextAlternativeIssues: reportsIssue should be true")
+	}
+}
+
+// Test the HTMLWithoutLang reporter with a pageReport with the lang attribute in the html element.
+// The reporter should not report the issue.
+func TestHTMLWithoutLangNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{},
+	}
+
+	reporter := reporters.NewHTMLWithoutLangReporter()
+	if reporter.ErrorType != reporter_errors.ErrorHTMLWithoutLang {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestHTMLWithoutLangNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the HTMLWithoutLang reporter with a pageReport without the lang attribute in the html element.
+// The reporter should report the issue.
+func TestHTMLWithoutLangIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Accessibility: models.Accessibility{MissingLang: true},
+	}
+
+	reporter := reporters.NewHTMLWithoutLangReporter()
+	if reporter.ErrorType != reporter_errors.ErrorHTMLWithoutLang {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestHTMLWithoutLangIssues: reportsIssue should be true")
+	}
+}
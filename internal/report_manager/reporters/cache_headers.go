@@ -0,0 +1,83 @@
+package reporters
+
+import (
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// Minimum freshness lifetime in seconds expected for static resources.
+const minStaticMaxAge = 7 * 24 * 60 * 60
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is an image, a script or a style, the status code is between 200 and 299
+// and the response can't be cached for at least a week.
+func NewStaticResourceShortCacheReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessful(pageReport) || !isStaticResource(pageReport.MediaType) {
+			return false
+		}
+
+		return pageReport.CacheHeaders.MaxAge < minStaticMaxAge
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorStaticResourceShortCache,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page can be
+// stored by shared caches while it sets cookies, which could be served to other users.
+func NewPublicCacheWithCookieReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) || !pageReport.CacheHeaders.SetCookie {
+			return false
+		}
+
+		for _, d := range strings.Split(strings.ToLower(pageReport.CacheHeaders.CacheControl), ",") {
+			d = strings.TrimSpace(d)
+			if d == "public" || strings.HasPrefix(d, "s-maxage") {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorPublicCacheWithCookie,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the status code is between 200 and 299 and the response has neither an ETag nor a
+// Last-Modified header, so it can't be revalidated once it is stale.
+func NewMissingCacheValidatorsReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessful(pageReport) {
+			return false
+		}
+
+		return pageReport.CacheHeaders.ETag == "" && pageReport.CacheHeaders.LastModified == ""
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorMissingCacheValidators,
+		Callback:  c,
+	}
+}
+
+// Returns true if the media type is an image, a script or a style.
+func isStaticResource(mediaType string) bool {
+	switch mediaType {
+	case "text/css", "text/javascript", "application/javascript", "application/x-javascript":
+		return true
+	}
+
+	return strings.HasPrefix(mediaType, "image/")
+}
@@ -0,0 +1,129 @@
+package reporters_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporters"
+)
+
+// Test the StaticResourceShortCache reporter with a static resource cached for a year.
+// The reporter should not report the issue.
+func TestStaticResourceShortCacheNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "image/png",
+		StatusCode:   200,
+		CacheHeaders: models.CacheHeaders{CacheControl: "public, max-age=31536000", MaxAge: 31536000},
+	}
+
+	reporter := reporters.NewStaticResourceShortCacheReporter()
+	if reporter.ErrorType != reporter_errors.ErrorStaticResourceShortCache {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestStaticResourceShortCacheNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the StaticResourceShortCache reporter with a static resource without max-age.
+// The reporter should report the issue.
+func TestStaticResourceShortCacheIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "image/png",
+		StatusCode:   200,
+		CacheHeaders: models.CacheHeaders{MaxAge: -1},
+	}
+
+	reporter := reporters.NewStaticResourceShortCacheReporter()
+	if reporter.ErrorType != reporter_errors.ErrorStaticResourceShortCache {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestStaticResourceShortCacheIssues: reportsIssue should be true")
+	}
+}
+
+// Test the PublicCacheWithCookie reporter with a private page setting cookies.
+// The reporter should not report the issue.
+func TestPublicCacheWithCookieNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "text/html",
+		StatusCode:   200,
+		CacheHeaders: models.CacheHeaders{CacheControl: "private, max-age=0", SetCookie: true},
+	}
+
+	reporter := reporters.NewPublicCacheWithCookieReporter()
+	if reporter.ErrorType != reporter_errors.ErrorPublicCacheWithCookie {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestPublicCacheWithCookieNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the PublicCacheWithCookie reporter with a public page setting cookies.
+// The reporter should report the issue.
+func TestPublicCacheWithCookieIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "text/html",
+		StatusCode:   200,
+		CacheHeaders: models.CacheHeaders{CacheControl: "public, max-age=600", SetCookie: true},
+	}
+
+	reporter := reporters.NewPublicCacheWithCookieReporter()
+	if reporter.ErrorType != reporter_errors.ErrorPublicCacheWithCookie {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestPublicCacheWithCookieIssues: reportsIssue should be true")
+	}
+}
+
+// Test the MissingCacheValidators reporter with a response with an ETag.
+// The reporter should not report the issue.
+func TestMissingCacheValidatorsNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "text/html",
+		StatusCode:   200,
+		CacheHeaders: models.CacheHeaders{ETag: `"abc"`},
+	}
+
+	reporter := reporters.NewMissingCacheValidatorsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingCacheValidators {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestMissingCacheValidatorsNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingCacheValidators reporter with a response without ETag or Last-Modified.
+// The reporter should report the issue.
+func TestMissingCacheValidatorsIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "text/html",
+		StatusCode:   200,
+		CacheHeaders: models.CacheHeaders{},
+	}
+
+	reporter := reporters.NewMissingCacheValidatorsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingCacheValidators {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestMissingCacheValidatorsIssues: reportsIssue should be true")
+	}
+}
@@ -0,0 +1,113 @@
+package reporters
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and a canonical
+// defined in the Link HTTP header differs from the one defined in the HTML or from another
+// canonical in the headers. Multiple canonical link tags in the HTML are reported by the
+// MultipleCanonicalTags reporter.
+func NewConflictingCanonicalsReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		for _, h := range pageReport.Canonicals {
+			if h.Source != models.CanonicalSourceHeader {
+				continue
+			}
+
+			for _, c := range pageReport.Canonicals {
+				if c.URL != h.URL {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorConflictingCanonicals,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and any of the page
+// canonicals points to a different registrable domain. Canonicals to a subdomain of the
+// same site, such as www.example.com and example.com, are not reported.
+func NewCrossDomainCanonicalReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		pu, err := url.Parse(pageReport.URL)
+		if err != nil {
+			return false
+		}
+
+		for _, c := range pageReport.Canonicals {
+			cu, err := url.Parse(c.URL)
+			if err == nil && registrableDomain(cu.Hostname()) != registrableDomain(pu.Hostname()) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorCrossDomainCanonical,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and any of the page
+// canonicals has query parameters or a fragment.
+func NewCanonicalWithParametersReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		for _, c := range pageReport.Canonicals {
+			cu, err := url.Parse(c.URL)
+			if err == nil && (cu.RawQuery != "" || cu.Fragment != "") {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorCanonicalWithParameters,
+		Callback:  c,
+	}
+}
+
+// Returns the registrable domain of the host, which is the public suffix plus one
+// more label, ex. "example.co.uk" for "www.example.co.uk". Hosts without a registrable
+// domain, such as IP addresses or "localhost", are returned lowercased.
+func registrableDomain(host string) string {
+	host = strings.ToLower(host)
+	d, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+
+	return d
+}
@@ -0,0 +1,170 @@
+package reporters_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporters"
+)
+
+// Test the ConflictingCanonicals reporter with a pageReport with the same canonical in the HTML and the headers.
+// The reporter should not report the issue.
+func TestConflictingCanonicalsNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/page",
+		Canonicals: []models.Canonical{
+			{URL: "https://example.com/page", Source: models.CanonicalSourceHTML},
+			{URL: "https://example.com/page", Source: models.CanonicalSourceHeader},
+		},
+	}
+
+	reporter := reporters.NewConflictingCanonicalsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorConflictingCanonicals {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestConflictingCanonicalsNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the ConflictingCanonicals reporter with a pageReport with different canonicals in the HTML and the headers.
+// The reporter should report the issue.
+func TestConflictingCanonicalsIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/page",
+		Canonicals: []models.Canonical{
+			{URL: "https://example.com/page", Source: models.CanonicalSourceHTML},
+			{URL: "https://example.com/other", Source: models.CanonicalSourceHeader},
+		},
+	}
+
+	reporter := reporters.NewConflictingCanonicalsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorConflictingCanonicals {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestConflictingCanonicalsIssues: reportsIssue should be true")
+	}
+}
+
+// Test the ConflictingCanonicals reporter with a pageReport with different canonicals in the Link headers.
+// The reporter should report the issue.
+func TestConflictingCanonicalHeadersIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/page",
+		Canonicals: []models.Canonical{
+			{URL: "https://example.com/a", Source: models.CanonicalSourceHeader},
+			{URL: "https://example.com/b", Source: models.CanonicalSourceHeader},
+		},
+	}
+
+	reporter := reporters.NewConflictingCanonicalsReporter()
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestConflictingCanonicalHeadersIssues: reportsIssue should be true")
+	}
+}
+
+// Test the CrossDomainCanonical reporter with a pageReport with canonicals in the same domain
+// and one of its subdomains. The reporter should not report the issue.
+func TestCrossDomainCanonicalNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.co.uk/page",
+		Canonicals: []models.Canonical{
+			{URL: "https://example.co.uk/page", Source: models.CanonicalSourceHTML},
+			{URL: "https://www.example.co.uk/page", Source: models.CanonicalSourceHeader},
+		},
+	}
+
+	reporter := reporters.NewCrossDomainCanonicalReporter()
+	if reporter.ErrorType != reporter_errors.ErrorCrossDomainCanonical {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestCrossDomainCanonicalNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the CrossDomainCanonical reporter with a pageReport with a canonical in another domain.
+// The reporter should report the issue.
+func TestCrossDomainCanonicalIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.co.uk/page",
+		Canonicals: []models.Canonical{
+			{URL: "https://other.co.uk/page", Source: models.CanonicalSourceHTML},
+		},
+	}
+
+	reporter := reporters.NewCrossDomainCanonicalReporter()
+	if reporter.ErrorType != reporter_errors.ErrorCrossDomainCanonical {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestCrossDomainCanonicalIssues: reportsIssue should be true")
+	}
+}
+
+// Test the CanonicalWithParameters reporter with a pageReport with a canonical without parameters.
+// The reporter should not report the issue.
+func TestCanonicalWithParametersNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/page",
+		Canonicals: []models.Canonical{
+			{URL: "https://example.com/page", Source: models.CanonicalSourceHTML},
+		},
+	}
+
+	reporter := reporters.NewCanonicalWithParametersReporter()
+	if reporter.ErrorType != reporter_errors.ErrorCanonicalWithParameters {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestCanonicalWithParametersNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the CanonicalWithParameters reporter with a pageReport with a canonical with a fragment.
+// The reporter should report the issue.
+func TestCanonicalWithParametersIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/page",
+		Canonicals: []models.Canonical{
+			{URL: "https://example.com/page#top", Source: models.CanonicalSourceHTML},
+		},
+	}
+
+	reporter := reporters.NewCanonicalWithParametersReporter()
+	if reporter.ErrorType != reporter_errors.ErrorCanonicalWithParameters {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestCanonicalWithParametersIssues: reportsIssue should be true")
+	}
+}
@@ -8,26 +8,19 @@ import (
 
 // Returns a report_manager.PageIssueReporter with a callback function that
 // checks if a page has little content. The callback returns true if the page is text/html,
-// has a 20x status code and less than a specified amount of words.
+// has a 20x status code and less than the project's minimum amount of words.
 func NewLittleContentReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		if pageReport.MediaType != "text/html" {
-			return false
-		}
-
-		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
-			return false
-		}
-
-		return pageReport.Words < 200
+		return pageReport.Words < thresholds.MinWords
 	}
 
 	return &report_manager.PageIssueReporter{
-		ErrorType: reporter_errors.ErrorLittleContent,
-		Callback:  c,
+		ErrorType:      reporter_errors.ErrorLittleContent,
+		Callback:       c,
+		UsesThresholds: true,
 	}
 }
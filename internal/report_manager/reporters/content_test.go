@@ -23,7 +23,7 @@ func TestLittelContentNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestLittelContentNoIssues: reportsIssue should be false")
@@ -45,9 +45,38 @@ func TestLittleContentIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestLittleContentIssues: reportsIssue should be true")
 	}
 }
+
+// Test the LittleContent reporter with a custom minimum amount of words.
+// The reporter should use the thresholds it receives instead of the defaults.
+func TestLittleContentThresholds(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Words:      150,
+	}
+
+	reporter := reporters.NewLittleContentReporter()
+	if reporter.UsesThresholds == false {
+		t.Errorf("TestLittleContentThresholds: UsesThresholds should be true")
+	}
+
+	thresholds := models.NewThresholds()
+	thresholds.MinWords = 100
+
+	if reporter.Callback(pageReport, thresholds) == true {
+		t.Errorf("TestLittleContentThresholds: reportsIssue should be false")
+	}
+
+	thresholds.MinWords = 300
+
+	if reporter.Callback(pageReport, thresholds) == false {
+		t.Errorf("TestLittleContentThresholds: reportsIssue should be true")
+	}
+}
@@ -10,16 +10,8 @@ import (
 // an empty or missing description. It returns true if the status code is between
 // 200 and 299, the media type is text/html and the description is not set.
 func NewEmptyDescriptionReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
-			return false
-		}
-
-		if pageReport.MediaType != "text/html" {
-			return false
-		}
-
-		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
@@ -34,52 +26,38 @@ func NewEmptyDescriptionReporter() *report_manager.PageIssueReporter {
 
 // Returns a report_manager.PageIssueReporter with a callback function that checks if a page has a short description.
 // The callback function returns true if the page is text/html, has a status code between 200 and 299,
-// and has a description of less than an specified amount of letters.
+// and has a description of less than the project's minimum description length.
 func NewShortDescriptionReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
-			return false
-		}
-
-		if pageReport.MediaType != "text/html" {
-			return false
-		}
-
-		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		return len(pageReport.Description) > 0 && len(pageReport.Description) < 80
+		return len(pageReport.Description) > 0 && len(pageReport.Description) < thresholds.DescriptionMinLength
 	}
 
 	return &report_manager.PageIssueReporter{
-		ErrorType: reporter_errors.ErrorShortDescription,
-		Callback:  c,
+		ErrorType:      reporter_errors.ErrorShortDescription,
+		Callback:       c,
+		UsesThresholds: true,
 	}
 }
 
 // Returns a report_manager.PageIssueReporter with a callback function that checks if a page has a short description.
 // The callback function returns true if the page is text/html, has a status code between 200 and 299,
-// and has a description of more than an specified amount of letters.
+// and has a description of more than the project's maximum description length.
 func NewLongDescriptionReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
-			return false
-		}
-
-		if pageReport.MediaType != "text/html" {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
-			return false
-		}
-
-		return len(pageReport.Description) > 160
+		return len(pageReport.Description) > thresholds.DescriptionMaxLength
 	}
 
 	return &report_manager.PageIssueReporter{
-		ErrorType: reporter_errors.ErrorLongDescription,
-		Callback:  c,
+		ErrorType:      reporter_errors.ErrorLongDescription,
+		Callback:       c,
+		UsesThresholds: true,
 	}
 }
@@ -23,7 +23,7 @@ func TestEmptyDescriptionNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestEmptyDescriptionNoIssues: reportsIssue should be false")
@@ -44,7 +44,7 @@ func TestEmptyDescriptionIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestEmptyDescriptionIssues: reportsIssue should be true")
@@ -68,7 +68,7 @@ func TestShortDescriptionNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestShortDescriptionNoIssues: reportsIssue should be false")
@@ -90,7 +90,7 @@ func TestShortDescriptionIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestShortDescriptionIssues: reportsIssue should be true")
@@ -114,7 +114,7 @@ func TestLongDescriptionNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestLongDescriptionNoIssues: reportsIssue should be false")
@@ -140,7 +140,7 @@ func TestLongDescriptionIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestLongDescriptionIssues: reportsIssue should be true")
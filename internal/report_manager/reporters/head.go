@@ -0,0 +1,80 @@
+package reporters
+
+import (
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page's head
+// is closed early by an element that is not allowed in it, leaving SEO tags such as the
+// canonical, hreflang or robots tags after the break. Search engines will ignore those tags.
+func NewHeadBreakingElementReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.HeadElements.BreakingElement != "" && len(pageReport.HeadElements.TagsAfterBreak) > 0
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorHeadBreakingElement,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the same id
+// attribute is used by more than one element in the page.
+func NewDuplicateIdsReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return len(pageReport.HeadElements.DuplicateIds) > 0
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorDuplicateIds,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page has
+// more than one title tag.
+func NewMultipleTitleTagsReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.HeadElements.Titles > 1
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorMultipleTitleTags,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page has
+// more than one canonical link tag.
+func NewMultipleCanonicalTagsReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.HeadElements.Canonicals > 1
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorMultipleCanonicalTags,
+		Callback:  c,
+	}
+}
@@ -0,0 +1,169 @@
+package reporters_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporters"
+)
+
+// Test the HeadBreakingElement reporter with a pageReport with a valid head.
+// The reporter should not report the issue.
+func TestHeadBreakingElementNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "text/html",
+		StatusCode:   200,
+		HeadElements: models.HeadElements{Titles: 1},
+	}
+
+	reporter := reporters.NewHeadBreakingElementReporter()
+	if reporter.ErrorType != reporter_errors.ErrorHeadBreakingElement {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestHeadBreakingElementNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the HeadBreakingElement reporter with a pageReport with a canonical tag after a head-breaking element.
+// The reporter should report the issue.
+func TestHeadBreakingElementIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "text/html",
+		StatusCode:   200,
+		HeadElements: models.HeadElements{BreakingElement: "img", TagsAfterBreak: []string{"canonical"}},
+	}
+
+	reporter := reporters.NewHeadBreakingElementReporter()
+	if reporter.ErrorType != reporter_errors.ErrorHeadBreakingElement {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestHeadBreakingElementIssues: reportsIssue should be true")
+	}
+}
+
+// Test the DuplicateIds reporter with a pageReport without duplicate ids.
+// The reporter should not report the issue.
+func TestDuplicateIdsNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "text/html",
+		StatusCode:   200,
+		HeadElements: models.HeadElements{},
+	}
+
+	reporter := reporters.NewDuplicateIdsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorDuplicateIds {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestDuplicateIdsNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the DuplicateIds reporter with a pageReport with duplicate ids.
+// The reporter should report the issue.
+func TestDuplicateIdsIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "text/html",
+		StatusCode:   200,
+		HeadElements: models.HeadElements{DuplicateIds: []string{"main"}},
+	}
+
+	reporter := reporters.NewDuplicateIdsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorDuplicateIds {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestDuplicateIdsIssues: reportsIssue should be true")
+	}
+}
+
+// Test the MultipleTitleTags reporter with a pageReport with one title tag.
+// The reporter should not report the issue.
+func TestMultipleTitleTagsNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "text/html",
+		StatusCode:   200,
+		HeadElements: models.HeadElements{Titles: 1},
+	}
+
+	reporter := reporters.NewMultipleTitleTagsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMultipleTitleTags {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestMultipleTitleTagsNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MultipleTitleTags reporter with a pageReport with two title tags.
+// The reporter should report the issue.
+func TestMultipleTitleTagsIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "text/html",
+		StatusCode:   200,
+		HeadElements: models.HeadElements{Titles: 2},
+	}
+
+	reporter := reporters.NewMultipleTitleTagsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMultipleTitleTags {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestMultipleTitleTagsIssues: reportsIssue should be true")
+	}
+}
+
+// Test the MultipleCanonicalTags reporter with a pageReport with one canonical tag.
+// The reporter should not report the issue.
+func TestMultipleCanonicalTagsNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "text/html",
+		StatusCode:   200,
+		HeadElements: models.HeadElements{Canonicals: 1},
+	}
+
+	reporter := reporters.NewMultipleCanonicalTagsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMultipleCanonicalTags {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestMultipleCanonicalTagsNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MultipleCanonicalTags reporter with a pageReport with two canonical tags.
+// The reporter should report the issue.
+func TestMultipleCanonicalTagsIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:      true,
+		MediaType:    "text/html",
+		StatusCode:   200,
+		HeadElements: models.HeadElements{Canonicals: 2},
+	}
+
+	reporter := reporters.NewMultipleCanonicalTagsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMultipleCanonicalTags {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestMultipleCanonicalTagsIssues: reportsIssue should be true")
+	}
+}
@@ -1,6 +1,8 @@
 package reporters
 
 import (
+	"strings"
+
 	"github.com/stjudewashere/seonaut/internal/models"
 	"github.com/stjudewashere/seonaut/internal/report_manager"
 	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
@@ -10,54 +12,109 @@ import (
 // the media type is text/html, the status code is between 200 and 299 and the page's html
 // doesn't have any H1 tag.
 func NewNoH1Reporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		if pageReport.MediaType != "text/html" {
+		return pageReport.H1 == ""
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorNoH1,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the heading tags
+// in the page's html doesn't have the correct order.
+func NewValidHeadingsOrderReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		if pageReport.StatusCode < 200 && pageReport.StatusCode >= 300 {
+		if pageReport.ValidHeadings == true {
 			return false
 		}
 
-		return pageReport.H1 == ""
+		return true
 	}
 
 	return &report_manager.PageIssueReporter{
-		ErrorType: reporter_errors.ErrorNoH1,
+		ErrorType: reporter_errors.ErrorNotValidHeadings,
 		Callback:  c,
 	}
 }
 
 // Returns a report_manager.PageIssueReporter with a callback function that returns true if
-// the media type is text/html, the status code is between 200 and 299 and the heading tags
-// in the page's html doesn't have the correct order.
-func NewValidHeadingsOrderReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
+// the media type is text/html, the status code is between 200 and 299 and the page's html
+// has more than one H1 heading.
+func NewMultipleH1Reporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		if pageReport.MediaType != "text/html" {
+		h1 := 0
+		for _, h := range pageReport.Headings {
+			if h.Level == 1 {
+				h1++
+			}
+		}
+
+		return h1 > 1
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorMultipleH1,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page's H1
+// heading is identical to the page title.
+func NewH1EqualsTitleReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		if pageReport.StatusCode < 200 && pageReport.StatusCode >= 300 {
+		if pageReport.H1 == "" {
 			return false
 		}
 
-		if pageReport.ValidHeadings == true {
+		return strings.EqualFold(strings.TrimSpace(pageReport.H1), strings.TrimSpace(pageReport.Title))
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorH1EqualsTitle,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page's html
+// has headings without text.
+func NewEmptyHeadingsReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		return true
+		for _, h := range pageReport.Headings {
+			if h.Text == "" {
+				return true
+			}
+		}
+
+		return false
 	}
 
 	return &report_manager.PageIssueReporter{
-		ErrorType: reporter_errors.ErrorNotValidHeadings,
+		ErrorType: reporter_errors.ErrorEmptyHeadings,
 		Callback:  c,
 	}
 }
@@ -23,7 +23,7 @@ func TestNoH1NoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestNoH1NoIssues: reportsIssue should be false")
@@ -44,13 +44,30 @@ func TestNoH1Issues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestNoH1Issues: reportsIssue should be true")
 	}
 }
 
+// Test the NoH1 reporter with a pageReport without H1 that returns a 404 status code.
+// The reporter should not report the issue.
+func TestNoH1ErrorStatusNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 404,
+	}
+
+	reporter := reporters.NewNoH1Reporter()
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestNoH1ErrorStatusNoIssues: reportsIssue should be false")
+	}
+}
+
 // Test the ValidHeadingsOrder reporter with a pageReport that has a valid heading order.
 // The reporter should not report the issue.
 func TestValidHeadingsOrderNoIssues(t *testing.T) {
@@ -66,7 +83,7 @@ func TestValidHeadingsOrderNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestValidHeadingsOrderNoIssues: reportsIssue should be false")
@@ -87,9 +104,154 @@ func TestValidHeadingsOrderIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestValidHeadingsOrderIssues: reportsIssue should be true")
 	}
 }
+
+// Test the MultipleH1 reporter with a pageReport that has a single H1 heading.
+// The reporter should not report the issue.
+func TestMultipleH1NoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Headings: []models.Heading{
+			{Level: 1, Text: "Title"},
+			{Level: 2, Text: "Subtitle"},
+		},
+	}
+
+	reporter := reporters.NewMultipleH1Reporter()
+	if reporter.ErrorType != reporter_errors.ErrorMultipleH1 {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestMultipleH1NoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MultipleH1 reporter with a pageReport that has two H1 headings.
+// The reporter should report the issue.
+func TestMultipleH1Issues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Headings: []models.Heading{
+			{Level: 1, Text: "Title"},
+			{Level: 1, Text: "Another title"},
+		},
+	}
+
+	reporter := reporters.NewMultipleH1Reporter()
+	if reporter.ErrorType != reporter_errors.ErrorMultipleH1 {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestMultipleH1Issues: reportsIssue should be true")
+	}
+}
+
+// Test the H1EqualsTitle reporter with a pageReport with an H1 different from the title.
+// The reporter should not report the issue.
+func TestH1EqualsTitleNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Title:      "Page title | Site",
+		H1:         "Page heading",
+	}
+
+	reporter := reporters.NewH1EqualsTitleReporter()
+	if reporter.ErrorType != reporter_errors.ErrorH1EqualsTitle {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestH1EqualsTitleNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the H1EqualsTitle reporter with a pageReport with an H1 identical to the title.
+// The reporter should report the issue.
+func TestH1EqualsTitleIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Title:      "Page title",
+		H1:         "Page Title",
+	}
+
+	reporter := reporters.NewH1EqualsTitleReporter()
+	if reporter.ErrorType != reporter_errors.ErrorH1EqualsTitle {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestH1EqualsTitleIssues: reportsIssue should be true")
+	}
+}
+
+// Test the EmptyHeadings reporter with a pageReport without empty headings.
+// The reporter should not report the issue.
+func TestEmptyHeadingsNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Headings: []models.Heading{
+			{Level: 1, Text: "Title"},
+		},
+	}
+
+	reporter := reporters.NewEmptyHeadingsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorEmptyHeadings {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestEmptyHeadingsNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the EmptyHeadings reporter with a pageReport with an empty heading.
+// The reporter should report the issue.
+func TestEmptyHeadingsIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Headings: []models.Heading{
+			{Level: 1, Text: "Title"},
+			{Level: 2, Text: ""},
+		},
+	}
+
+	reporter := reporters.NewEmptyHeadingsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorEmptyHeadings {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestEmptyHeadingsIssues: reportsIssue should be true")
+	}
+}
@@ -0,0 +1,110 @@
+package reporters
+
+import (
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/hreflang"
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and any of the page
+// hreflangs has a value that is not a valid language and region code, such as en-UK.
+func NewInvalidHreflangCodeReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		for _, h := range pageReport.Hreflangs {
+			if !hreflang.ValidCode(h.Lang) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorInvalidHreflangCode,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page has
+// hreflangs but none of them points to the page itself.
+func NewHreflangMissingSelfReferenceReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) || len(pageReport.Hreflangs) == 0 {
+			return false
+		}
+
+		for _, h := range pageReport.Hreflangs {
+			if h.URL == pageReport.URL {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorHreflangMissingSelfReference,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page has
+// hreflangs but none of them is x-default.
+func NewHreflangMissingXDefaultReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) || len(pageReport.Hreflangs) == 0 {
+			return false
+		}
+
+		for _, h := range pageReport.Hreflangs {
+			if strings.EqualFold(h.Lang, hreflang.XDefault) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorHreflangMissingXDefault,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page has
+// the same hreflang language pointing to different URLs.
+func NewHreflangDuplicateLangReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		urls := make(map[string]string)
+		for _, h := range pageReport.Hreflangs {
+			lang := strings.ToLower(h.Lang)
+			if u, ok := urls[lang]; ok && u != h.URL {
+				return true
+			}
+
+			urls[lang] = h.URL
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorHreflangDuplicateLang,
+		Callback:  c,
+	}
+}
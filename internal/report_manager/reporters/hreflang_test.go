@@ -0,0 +1,203 @@
+package reporters_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporters"
+)
+
+// Test the InvalidHreflangCode reporter with a pageReport with valid hreflang codes.
+// The reporter should not report the issue.
+func TestInvalidHreflangCodeNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/en/",
+		Hreflangs: []models.Hreflang{
+			{URL: "https://example.com/en/", Lang: "en-GB"},
+			{URL: "https://example.com/es/", Lang: "es"},
+			{URL: "https://example.com/", Lang: "x-default"},
+		},
+	}
+
+	reporter := reporters.NewInvalidHreflangCodeReporter()
+	if reporter.ErrorType != reporter_errors.ErrorInvalidHreflangCode {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestInvalidHreflangCodeNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the InvalidHreflangCode reporter with a pageReport with the en-UK hreflang code.
+// The reporter should report the issue.
+func TestInvalidHreflangCodeIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/en/",
+		Hreflangs: []models.Hreflang{
+			{URL: "https://example.com/en/", Lang: "en-UK"},
+			{URL: "https://example.com/es/", Lang: "es"},
+		},
+	}
+
+	reporter := reporters.NewInvalidHreflangCodeReporter()
+	if reporter.ErrorType != reporter_errors.ErrorInvalidHreflangCode {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestInvalidHreflangCodeIssues: reportsIssue should be true")
+	}
+}
+
+// Test the HreflangMissingSelfReference reporter with a pageReport with a self-referencing hreflang.
+// The reporter should not report the issue.
+func TestHreflangMissingSelfReferenceNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/en/",
+		Hreflangs: []models.Hreflang{
+			{URL: "https://example.com/en/", Lang: "en-GB"},
+			{URL: "https://example.com/es/", Lang: "es"},
+		},
+	}
+
+	reporter := reporters.NewHreflangMissingSelfReferenceReporter()
+	if reporter.ErrorType != reporter_errors.ErrorHreflangMissingSelfReference {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestHreflangMissingSelfReferenceNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the HreflangMissingSelfReference reporter with a pageReport without a self-referencing hreflang.
+// The reporter should report the issue.
+func TestHreflangMissingSelfReferenceIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/en/",
+		Hreflangs: []models.Hreflang{
+			{URL: "https://example.com/es/", Lang: "es"},
+		},
+	}
+
+	reporter := reporters.NewHreflangMissingSelfReferenceReporter()
+	if reporter.ErrorType != reporter_errors.ErrorHreflangMissingSelfReference {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestHreflangMissingSelfReferenceIssues: reportsIssue should be true")
+	}
+}
+
+// Test the HreflangMissingXDefault reporter with a pageReport with an x-default hreflang.
+// The reporter should not report the issue.
+func TestHreflangMissingXDefaultNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/en/",
+		Hreflangs: []models.Hreflang{
+			{URL: "https://example.com/en/", Lang: "en-GB"},
+			{URL: "https://example.com/", Lang: "x-default"},
+		},
+	}
+
+	reporter := reporters.NewHreflangMissingXDefaultReporter()
+	if reporter.ErrorType != reporter_errors.ErrorHreflangMissingXDefault {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestHreflangMissingXDefaultNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the HreflangMissingXDefault reporter with a pageReport without an x-default hreflang.
+// The reporter should report the issue.
+func TestHreflangMissingXDefaultIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/en/",
+		Hreflangs: []models.Hreflang{
+			{URL: "https://example.com/en/", Lang: "en-GB"},
+			{URL: "https://example.com/es/", Lang: "es"},
+		},
+	}
+
+	reporter := reporters.NewHreflangMissingXDefaultReporter()
+	if reporter.ErrorType != reporter_errors.ErrorHreflangMissingXDefault {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestHreflangMissingXDefaultIssues: reportsIssue should be true")
+	}
+}
+
+// Test the HreflangDuplicateLang reporter with a pageReport with one URL per hreflang language.
+// The reporter should not report the issue.
+func TestHreflangDuplicateLangNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/en/",
+		Hreflangs: []models.Hreflang{
+			{URL: "https://example.com/en/", Lang: "en-GB"},
+			{URL: "https://example.com/es/", Lang: "es"},
+			{URL: "https://example.com/es/", Lang: "ES"},
+		},
+	}
+
+	reporter := reporters.NewHreflangDuplicateLangReporter()
+	if reporter.ErrorType != reporter_errors.ErrorHreflangDuplicateLang {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestHreflangDuplicateLangNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the HreflangDuplicateLang reporter with a pageReport with the same hreflang language pointing to different URLs.
+// The reporter should report the issue.
+func TestHreflangDuplicateLangIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/en/",
+		Hreflangs: []models.Hreflang{
+			{URL: "https://example.com/en/", Lang: "en-GB"},
+			{URL: "https://example.com/es/", Lang: "es"},
+			{URL: "https://example.com/es-es/", Lang: "es"},
+		},
+	}
+
+	reporter := reporters.NewHreflangDuplicateLangReporter()
+	if reporter.ErrorType != reporter_errors.ErrorHreflangDuplicateLang {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestHreflangDuplicateLangIssues: reportsIssue should be true")
+	}
+}
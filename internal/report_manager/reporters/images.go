@@ -1,6 +1,11 @@
 package reporters
 
 import (
+	"net/url"
+	"path"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/stjudewashere/seonaut/internal/models"
 	"github.com/stjudewashere/seonaut/internal/report_manager"
 	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
@@ -10,7 +15,7 @@ import (
 // if a page has images with no alt attribute. The callback returns true in case
 // the page is text/html and contains images with empty or missing alt attribute.
 func NewAltTextReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 		if pageReport.Crawled == false {
 			return false
 		}
@@ -33,3 +38,104 @@ func NewAltTextReporter() *report_manager.PageIssueReporter {
 		Callback:  c,
 	}
 }
+
+// Alt texts longer than this number of characters are reported as too long.
+// Screen readers may cut off longer alt texts.
+const maxAltTextLength = 125
+
+// Returns a report_manager.PageIssueReporter with a callback function to check
+// if a page has images without width or height attributes. The callback returns true
+// in case the page is text/html and contains images with a missing dimension attribute,
+// which may cause layout shifts while the page loads.
+func NewImageMissingDimensionsReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if pageReport.Crawled == false {
+			return false
+		}
+
+		if pageReport.MediaType != "text/html" {
+			return false
+		}
+
+		for _, i := range pageReport.Images {
+			if i.Width == "" || i.Height == "" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorImageMissingDimensions,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function to check
+// if a page has images with a very long alt text. The callback returns true in case
+// the page is text/html and contains images with an alt text longer than maxAltTextLength.
+func NewAltTextTooLongReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if pageReport.Crawled == false {
+			return false
+		}
+
+		if pageReport.MediaType != "text/html" {
+			return false
+		}
+
+		for _, i := range pageReport.Images {
+			if utf8.RuneCountInString(strings.TrimSpace(i.Alt)) > maxAltTextLength {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorAltTextTooLong,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function to check
+// if a page has images whose alt text is the file name of the image. The callback
+// returns true in case the page is text/html and contains images with an alt text
+// equal to the file name, with or without its extension.
+func NewAltTextFilenameReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if pageReport.Crawled == false {
+			return false
+		}
+
+		if pageReport.MediaType != "text/html" {
+			return false
+		}
+
+		for _, i := range pageReport.Images {
+			alt := strings.ToLower(strings.TrimSpace(i.Alt))
+			if alt == "" {
+				continue
+			}
+
+			u, err := url.Parse(i.URL)
+			if err != nil {
+				continue
+			}
+
+			name := strings.ToLower(path.Base(u.Path))
+			if alt == name || alt == strings.TrimSuffix(name, path.Ext(name)) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorAltTextFilename,
+		Callback:  c,
+	}
+}
@@ -1,6 +1,7 @@
 package reporters_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stjudewashere/seonaut/internal/models"
@@ -26,7 +27,7 @@ func TestAltTextReporterNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestAltTextReporterNoIssues: reportsIssue should be false")
@@ -49,9 +50,135 @@ func TestAltTextReporterIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestAltTextReporterIssues: reportsIssue should be true")
 	}
 }
+
+// Test the ImageMissingDimensions reporter with a pageReport that has images
+// with width and height attributes. The reporter should not report the issue.
+func TestImageMissingDimensionsNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:   true,
+		MediaType: "text/html",
+		Images:    []models.Image{{URL: "https://example.com/img.webp", Width: "100", Height: "50"}},
+	}
+
+	reporter := reporters.NewImageMissingDimensionsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorImageMissingDimensions {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestImageMissingDimensionsNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the ImageMissingDimensions reporter with a pageReport that has an image
+// without height attribute. The reporter should report the issue.
+func TestImageMissingDimensionsIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:   true,
+		MediaType: "text/html",
+		Images:    []models.Image{{URL: "https://example.com/img.webp", Width: "100"}},
+	}
+
+	reporter := reporters.NewImageMissingDimensionsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorImageMissingDimensions {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestImageMissingDimensionsIssues: reportsIssue should be true")
+	}
+}
+
+// Test the AltTextTooLong reporter with a pageReport that has an image with a short
+// alt text. The reporter should not report the issue.
+func TestAltTextTooLongNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:   true,
+		MediaType: "text/html",
+		Images:    []models.Image{{URL: "https://example.com/img.webp", Alt: "A red bicycle"}},
+	}
+
+	reporter := reporters.NewAltTextTooLongReporter()
+	if reporter.ErrorType != reporter_errors.ErrorAltTextTooLong {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestAltTextTooLongNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the AltTextTooLong reporter with a pageReport that has an image with a very
+// long alt text. The reporter should report the issue.
+func TestAltTextTooLongIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:   true,
+		MediaType: "text/html",
+		Images:    []models.Image{{URL: "https://example.com/img.webp", Alt: strings.Repeat("bicycle ", 20)}},
+	}
+
+	reporter := reporters.NewAltTextTooLongReporter()
+	if reporter.ErrorType != reporter_errors.ErrorAltTextTooLong {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestAltTextTooLongIssues: reportsIssue should be true")
+	}
+}
+
+// Test the AltTextFilename reporter with a pageReport that has an image with a
+// descriptive alt text. The reporter should not report the issue.
+func TestAltTextFilenameNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:   true,
+		MediaType: "text/html",
+		Images:    []models.Image{{URL: "https://example.com/img/IMG_1234.jpg", Alt: "A red bicycle"}},
+	}
+
+	reporter := reporters.NewAltTextFilenameReporter()
+	if reporter.ErrorType != reporter_errors.ErrorAltTextFilename {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestAltTextFilenameNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the AltTextFilename reporter with a pageReport that has an image whose alt
+// text is the file name. The reporter should report the issue.
+func TestAltTextFilenameIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:   true,
+		MediaType: "text/html",
+		Images:    []models.Image{{URL: "https://example.com/img/IMG_1234.jpg", Alt: "img_1234"}},
+	}
+
+	reporter := reporters.NewAltTextFilenameReporter()
+	if reporter.ErrorType != reporter_errors.ErrorAltTextFilename {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestAltTextFilenameIssues: reportsIssue should be true")
+	}
+}
@@ -9,7 +9,7 @@ import (
 // Returns a report_manager.PageIssueReporter with a callback function that returns true if
 // the page is not indexable by search engines.
 func NewNoIndexableReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 		return pageReport.Noindex
 	}
 
@@ -22,7 +22,7 @@ func NewNoIndexableReporter() *report_manager.PageIssueReporter {
 // Returns a report_manager.PageIssueReporter with a callback function that returns true if
 // the page is blocked by the robots.txt file.
 func NewBlockedByRobotstxtReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 		return pageReport.BlockedByRobotstxt
 	}
 
@@ -35,7 +35,7 @@ func NewBlockedByRobotstxtReporter() *report_manager.PageIssueReporter {
 // Returns a report_manager.PageIssueReporter with a callback function that returns true if
 // the pageReport is non-indexable and it is included in the sitemap.
 func NewNoIndexInSitemapReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 		return pageReport.InSitemap && pageReport.Noindex
 	}
 
@@ -48,7 +48,7 @@ func NewNoIndexInSitemapReporter() *report_manager.PageIssueReporter {
 // Returns a report_manager.PageIssueReporter with a callback function that returns true if
 // the page is included in the sitemap and it is also blocked by the robots.txt file.
 func NewSitemapAndBlockedReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 		return pageReport.InSitemap && pageReport.BlockedByRobotstxt
 	}
 
@@ -61,7 +61,7 @@ func NewSitemapAndBlockedReporter() *report_manager.PageIssueReporter {
 // Returns a report_manager.PageIssueReporter with a callback function that returns true if
 // the page is non canonical and it is included in the sitemap.
 func NewNonCanonicalInSitemapReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 		if pageReport.Crawled == false {
 			return false
 		}
@@ -82,3 +82,43 @@ func NewNonCanonicalInSitemapReporter() *report_manager.PageIssueReporter {
 		Callback:  c,
 	}
 }
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page has conflicting robots directives, ex. "index" and "noindex" in the X-Robots-Tag
+// header and the robots meta tag.
+func NewRobotsConflictReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if pageReport.Crawled == false {
+			return false
+		}
+
+		return pageReport.RobotsConflict
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorRobotsConflict,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page is a non-HTML file, such as a PDF document, with the noindex directive in the
+// X-Robots-Tag header.
+func NewNoindexNonHTMLReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessful(pageReport) {
+			return false
+		}
+
+		if pageReport.MediaType == "" || pageReport.MediaType == "text/html" {
+			return false
+		}
+
+		return pageReport.Noindex
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorNoindexNonHTML,
+		Callback:  c,
+	}
+}
@@ -18,7 +18,7 @@ func TestNoIndexableNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestNoIndexableNoIssues: reportsIssue should be false")
@@ -37,7 +37,7 @@ func TestNoIndexableIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestNoIndexableIssues: reportsIssue should be true")
@@ -54,7 +54,7 @@ func TestBlockedByRobotstxtNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestBlockedByRobotstxtNoIssues: reportsIssue should be false")
@@ -73,7 +73,7 @@ func TestBlockedByRobotstxtIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestBlockedByRobotstxtIssues: reportsIssue should be true")
@@ -92,7 +92,7 @@ func TestNoIndexInSitemapNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestNoIndexInSitemapNoIssues: reportsIssue should be false")
@@ -112,7 +112,7 @@ func TestNoIndexInSitemapIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestNoIndexInSitemapIssues: reportsIssue should be true")
@@ -131,7 +131,7 @@ func TestSitemapAndBlockedNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestSitemapAndBlockedNoIssues: reportsIssue should be false")
@@ -151,7 +151,7 @@ func TestSitemapAndBlockedIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestSitemapAndBlockedIssues: reportsIssue should be true")
@@ -172,7 +172,7 @@ func TestNonCanonicalInSitemapNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestNonCanonicalInSitemapNoIssues: reportsIssue should be false")
@@ -195,9 +195,92 @@ func TestNonCanonicalInSitemapIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestNonCanonicalInSitemapIssues: reportsIssue should be true")
 	}
 }
+
+// Test the RobotsConflict reporter with a pageReport without conflicting robots directives.
+// The reporter should not report the issue.
+func TestRobotsConflictNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled: true,
+	}
+
+	reporter := reporters.NewRobotsConflictReporter()
+	if reporter.ErrorType != reporter_errors.ErrorRobotsConflict {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestRobotsConflictNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the RobotsConflict reporter with a pageReport with conflicting robots directives.
+// The reporter should report the issue.
+func TestRobotsConflictIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:        true,
+		RobotsConflict: true,
+	}
+
+	reporter := reporters.NewRobotsConflictReporter()
+	if reporter.ErrorType != reporter_errors.ErrorRobotsConflict {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestRobotsConflictIssues: reportsIssue should be true")
+	}
+}
+
+// Test the NoindexNonHTML reporter with a noindex HTML pageReport.
+// The reporter should not report the issue.
+func TestNoindexNonHTMLNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Noindex:    true,
+	}
+
+	reporter := reporters.NewNoindexNonHTMLReporter()
+	if reporter.ErrorType != reporter_errors.ErrorNoindexNonHTML {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestNoindexNonHTMLNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the NoindexNonHTML reporter with a noindex PDF pageReport.
+// The reporter should report the issue.
+func TestNoindexNonHTMLIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "application/pdf",
+		StatusCode: 200,
+		Noindex:    true,
+	}
+
+	reporter := reporters.NewNoindexNonHTMLReporter()
+	if reporter.ErrorType != reporter_errors.ErrorNoindexNonHTML {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestNoindexNonHTMLIssues: reportsIssue should be true")
+	}
+}
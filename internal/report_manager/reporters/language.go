@@ -9,7 +9,7 @@ import (
 // Returns a report_manager.PageIssueReporter with a callback function that returns true if
 // the status code media type is text/html and the page's html language is not valid.
 func NewInvalidLangReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 		if pageReport.Crawled == false {
 			return false
 		}
@@ -34,7 +34,7 @@ func NewInvalidLangReporter() *report_manager.PageIssueReporter {
 // Returns a report_manager.PageIssueReporter with a callback function that returns true if
 // the status code media type is text/html and the page's html language is missing or empty.
 func NewMissingLangReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 		if pageReport.Crawled == false {
 			return false
 		}
@@ -8,27 +8,20 @@ import (
 
 // Returns a report_manager.PageIssueReporter with a callback function that returns true if
 // the media type is text/html, the status code is between 200 and 299 and the page's html
-// contains too many links.
+// contains more links than the project's maximum.
 func NewTooManyLinksReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		if pageReport.MediaType != "text/html" {
-			return false
-		}
-
-		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
-			return false
-		}
-
-		return len(pageReport.Links) > 100
+		return len(pageReport.Links) > thresholds.MaxLinks
 	}
 
 	return &report_manager.PageIssueReporter{
-		ErrorType: reporter_errors.ErrorTooManyLinks,
-		Callback:  c,
+		ErrorType:      reporter_errors.ErrorTooManyLinks,
+		Callback:       c,
+		UsesThresholds: true,
 	}
 }
 
@@ -36,16 +29,8 @@ func NewTooManyLinksReporter() *report_manager.PageIssueReporter {
 // the media type is text/html, the status code is between 200 and 299 and the page's html
 // contains internal links with the nofollow attribute.
 func NewInternalNoFollowLinksReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
-			return false
-		}
-
-		if pageReport.MediaType != "text/html" {
-			return false
-		}
-
-		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
@@ -68,16 +53,8 @@ func NewInternalNoFollowLinksReporter() *report_manager.PageIssueReporter {
 // the media type is text/html, the status code is between 200 and 299 and the page's html
 // contains external links without the nofollow attribute.
 func NewExternalLinkWitoutNoFollowReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
-			return false
-		}
-
-		if pageReport.MediaType != "text/html" {
-			return false
-		}
-
-		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
@@ -100,16 +77,8 @@ func NewExternalLinkWitoutNoFollowReporter() *report_manager.PageIssueReporter {
 // the media type is text/html, the status code is between 200 and 299 and the page's html
 // contains internal links with the http scheme instead of https.
 func NewHTTPLinksReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
-			return false
-		}
-
-		if pageReport.MediaType != "text/html" {
-			return false
-		}
-
-		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
@@ -132,24 +101,47 @@ func NewHTTPLinksReporter() *report_manager.PageIssueReporter {
 // the media type is text/html, the status code is between 200 and 299 and the page's html
 // contains no internal or external links.
 func NewDeadendReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		if pageReport.MediaType != "text/html" {
+		return len(pageReport.Links)+len(pageReport.ExternalLinks) == 0
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorDeadend,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page's html
+// contains internal or external links without anchor text. The alt text of the images is used
+// as anchor text in image links.
+func NewEmptyAnchorTextReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
-			return false
+		for _, l := range pageReport.Links {
+			if l.Text == "" {
+				return true
+			}
 		}
 
-		return len(pageReport.Links)+len(pageReport.ExternalLinks) == 0
+		for _, l := range pageReport.ExternalLinks {
+			if l.Text == "" {
+				return true
+			}
+		}
+
+		return false
 	}
 
 	return &report_manager.PageIssueReporter{
-		ErrorType: reporter_errors.ErrorDeadend,
+		ErrorType: reporter_errors.ErrorEmptyAnchorText,
 		Callback:  c,
 	}
 }
@@ -27,7 +27,7 @@ func TestTooManyLinksNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestTooManyLinksNoIssues: reportsIssue should be false")
@@ -52,7 +52,7 @@ func TestTooManyLinksIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestTooManyLinksIssues: reportsIssue should be true")
@@ -75,7 +75,7 @@ func TestInternalNoFollowLinksNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestInternalNoFollowLinksNoIssues: reportsIssue should be false")
@@ -98,7 +98,7 @@ func TestInternalNoFollowLinksIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestInternalNoFollowLinksIssues: reportsIssue should be true")
@@ -121,7 +121,7 @@ func TestExternalLinkWitoutNoFollowNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestExternalLinkWitoutNoFollowNoIssues: reportsIssue should be false")
@@ -144,7 +144,7 @@ func TestExternalLinkWitoutNoFollowIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestExternalLinkWitoutNoFollowIssues: reportsIssue should be true")
@@ -179,7 +179,7 @@ func TestHTTPLinksNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestHTTPLinksNoIssues: reportsIssue should be false")
@@ -214,7 +214,7 @@ func TestHTTPLinksIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestHTTPLinksIssues: reportsIssue should be true")
@@ -249,7 +249,7 @@ func TestDeadendNoIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == true {
 		t.Errorf("TestHTTPLinksIssues: reportsIssue should be false")
@@ -270,9 +270,84 @@ func TestDeadendIssues(t *testing.T) {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
 
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	if reportsIssue == false {
 		t.Errorf("TestHTTPLinksIssues: reportsIssue should be true")
 	}
 }
+
+// Test the EmptyAnchorText reporter with a pageReport whose links have anchor text.
+// The reporter should not report the issue.
+func TestEmptyAnchorTextNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:       true,
+		MediaType:     "text/html",
+		StatusCode:    200,
+		Links:         []models.Link{{URL: "https://example.com/about", Text: "About"}},
+		ExternalLinks: []models.Link{{URL: "https://example.org", Text: "Example logo", Image: true}},
+	}
+
+	reporter := reporters.NewEmptyAnchorTextReporter()
+	if reporter.ErrorType != reporter_errors.ErrorEmptyAnchorText {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestEmptyAnchorTextNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the EmptyAnchorText reporter with a pageReport that has an image link without alt text.
+// The reporter should report the issue.
+func TestEmptyAnchorTextIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Links: []models.Link{
+			{URL: "https://example.com/about", Text: "About"},
+			{URL: "https://example.com/", Image: true},
+		},
+	}
+
+	reporter := reporters.NewEmptyAnchorTextReporter()
+	if reporter.ErrorType != reporter_errors.ErrorEmptyAnchorText {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestEmptyAnchorTextIssues: reportsIssue should be true")
+	}
+}
+
+// Test the TooManyLinks reporter with a custom maximum amount of links.
+// The reporter should use the thresholds it receives instead of the defaults.
+func TestTooManyLinksThresholds(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Links:      make([]models.Link, 50),
+	}
+
+	reporter := reporters.NewTooManyLinksReporter()
+	if reporter.UsesThresholds == false {
+		t.Errorf("TestTooManyLinksThresholds: UsesThresholds should be true")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestTooManyLinksThresholds: reportsIssue should be false")
+	}
+
+	thresholds := models.NewThresholds()
+	thresholds.MaxLinks = 40
+
+	if reporter.Callback(pageReport, thresholds) == false {
+		t.Errorf("TestTooManyLinksThresholds: reportsIssue should be true")
+	}
+}
@@ -0,0 +1,144 @@
+package reporters
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page doesn't
+// have the viewport meta tag.
+func NewMissingViewportReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.PageSetup.Viewport == ""
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorMissingViewport,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page's viewport
+// doesn't set the width to the device width or prevents users from zooming in, either with
+// user-scalable=no or with a maximum-scale lower than 2.
+func NewInvalidViewportReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		if pageReport.PageSetup.Viewport == "" {
+			return false
+		}
+
+		properties := viewportProperties(pageReport.PageSetup.Viewport)
+		if properties["width"] != "device-width" {
+			return true
+		}
+
+		if s := properties["user-scalable"]; s == "no" || s == "0" {
+			return true
+		}
+
+		if m, ok := properties["maximum-scale"]; ok {
+			scale, err := strconv.ParseFloat(m, 64)
+			if err == nil && scale < 2 {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorInvalidViewport,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the charset declared
+// in the Content-Type HTTP header is different from the charset declared in the HTML.
+// Aliases of the same encoding, such as "latin1" and "iso-8859-1", are not reported.
+func NewCharsetMismatchReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		s := pageReport.PageSetup
+		if s.HeaderCharset == "" || s.MetaCharset == "" {
+			return false
+		}
+
+		return charsetName(s.HeaderCharset) != charsetName(s.MetaCharset)
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorCharsetMismatch,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page doesn't
+// have a favicon, either with a favicon link or with a favicon.ico file in the site root.
+func NewMissingFaviconReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.PageSetup.Favicon == ""
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorMissingFavicon,
+		Callback:  c,
+	}
+}
+
+// Returns the canonical name of the charset as defined in the WHATWG Encoding standard,
+// so aliases of the same encoding have the same name. Unknown charsets are returned lowercased.
+func charsetName(charset string) string {
+	charset = strings.ToLower(strings.TrimSpace(charset))
+	e, err := htmlindex.Get(charset)
+	if err != nil {
+		return charset
+	}
+
+	name, err := htmlindex.Name(e)
+	if err != nil {
+		return charset
+	}
+
+	return name
+}
+
+// Returns the lowercased properties of the viewport content.
+// ex. "width=device-width, initial-scale=1" returns {"width": "device-width", "initial-scale": "1"}
+func viewportProperties(viewport string) map[string]string {
+	properties := make(map[string]string)
+	for _, p := range strings.FieldsFunc(strings.ToLower(viewport), func(r rune) bool { return r == ',' || r == ';' }) {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		properties[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+
+	return properties
+}
@@ -0,0 +1,191 @@
+package reporters_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporters"
+)
+
+// Test the MissingViewport reporter with a pageReport that has a viewport.
+// The reporter should not report the issue.
+func TestMissingViewportNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		PageSetup:  models.PageSetup{Viewport: "width=device-width, initial-scale=1"},
+	}
+
+	reporter := reporters.NewMissingViewportReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingViewport {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestMissingViewportNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingViewport reporter with a pageReport without viewport.
+// The reporter should report the issue.
+func TestMissingViewportIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := reporters.NewMissingViewportReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingViewport {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestMissingViewportIssues: reportsIssue should be true")
+	}
+}
+
+// Test the InvalidViewport reporter with a pageReport that has a responsive viewport.
+// The reporter should not report the issue.
+func TestInvalidViewportNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		PageSetup:  models.PageSetup{Viewport: "width=device-width, initial-scale=1, maximum-scale=5"},
+	}
+
+	reporter := reporters.NewInvalidViewportReporter()
+	if reporter.ErrorType != reporter_errors.ErrorInvalidViewport {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestInvalidViewportNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the InvalidViewport reporter with pageReports that have viewports that are not
+// responsive or disable zooming. The reporter should report the issue.
+func TestInvalidViewportIssues(t *testing.T) {
+	viewports := []string{
+		"width=1024",
+		"width=device-width, user-scalable=no",
+		"width=device-width, initial-scale=1, maximum-scale=1",
+	}
+
+	reporter := reporters.NewInvalidViewportReporter()
+	if reporter.ErrorType != reporter_errors.ErrorInvalidViewport {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	for _, v := range viewports {
+		pageReport := &models.PageReport{
+			Crawled:    true,
+			MediaType:  "text/html",
+			StatusCode: 200,
+			PageSetup:  models.PageSetup{Viewport: v},
+		}
+
+		if reporter.Callback(pageReport, models.NewThresholds()) == false {
+			t.Errorf("TestInvalidViewportIssues: reportsIssue should be true for %s", v)
+		}
+	}
+}
+
+// Test the CharsetMismatch reporter with a pageReport with the same charset in the
+// header and the HTML. The reporter should not report the issue.
+func TestCharsetMismatchNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		PageSetup:  models.PageSetup{HeaderCharset: "utf-8", MetaCharset: "utf-8"},
+	}
+
+	reporter := reporters.NewCharsetMismatchReporter()
+	if reporter.ErrorType != reporter_errors.ErrorCharsetMismatch {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestCharsetMismatchNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the CharsetMismatch reporter with a pageReport with different charsets in the
+// header and the HTML. The reporter should report the issue.
+func TestCharsetMismatchIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		PageSetup:  models.PageSetup{HeaderCharset: "iso-8859-1", MetaCharset: "utf-8"},
+	}
+
+	reporter := reporters.NewCharsetMismatchReporter()
+	if reporter.ErrorType != reporter_errors.ErrorCharsetMismatch {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestCharsetMismatchIssues: reportsIssue should be true")
+	}
+}
+
+// Test the CharsetMismatch reporter with a pageReport with different names of the
+// same charset in the header and the HTML. The reporter should not report the issue.
+func TestCharsetMismatchAliasNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		PageSetup:  models.PageSetup{HeaderCharset: "latin1", MetaCharset: "ISO-8859-1"},
+	}
+
+	reporter := reporters.NewCharsetMismatchReporter()
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestCharsetMismatchAliasNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingFavicon reporter with a pageReport that has a favicon.
+// The reporter should not report the issue.
+func TestMissingFaviconNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		PageSetup:  models.PageSetup{Favicon: "https://example.com/favicon.ico"},
+	}
+
+	reporter := reporters.NewMissingFaviconReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingFavicon {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestMissingFaviconNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingFavicon reporter with a pageReport without favicon.
+// The reporter should report the issue.
+func TestMissingFaviconIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := reporters.NewMissingFaviconReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingFavicon {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestMissingFaviconIssues: reportsIssue should be true")
+	}
+}
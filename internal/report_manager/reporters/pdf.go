@@ -0,0 +1,61 @@
+package reporters
+
+import (
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is application/pdf, the status code is between 200 and 299 and the document
+// doesn't have a title.
+func NewPDFMissingTitleReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulPDF(pageReport) {
+			return false
+		}
+
+		return pageReport.Title == ""
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorPDFMissingTitle,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is application/pdf, the status code is between 200 and 299 and the document
+// doesn't specify its language.
+func NewPDFMissingLangReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulPDF(pageReport) {
+			return false
+		}
+
+		return pageReport.Lang == ""
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorPDFMissingLang,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is application/pdf, the status code is between 200 and 299 and the document
+// has pages but no extractable text, as it happens with scanned documents.
+func NewPDFWithoutTextReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulPDF(pageReport) {
+			return false
+		}
+
+		return pageReport.PDF.Pages > 0 && pageReport.Words == 0
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorPDFWithoutText,
+		Callback:  c,
+	}
+}
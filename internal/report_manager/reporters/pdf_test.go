@@ -0,0 +1,128 @@
+package reporters_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporters"
+)
+
+// Test the PDFMissingTitle reporter with a PDF document with a title.
+// The reporter should not report the issue.
+func TestPDFMissingTitleNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "application/pdf",
+		StatusCode: 200,
+		Title:      "Annual report",
+	}
+
+	reporter := reporters.NewPDFMissingTitleReporter()
+	if reporter.ErrorType != reporter_errors.ErrorPDFMissingTitle {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestPDFMissingTitleNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the PDFMissingTitle reporter with a PDF document without a title.
+// The reporter should report the issue.
+func TestPDFMissingTitleIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "application/pdf",
+		StatusCode: 200,
+	}
+
+	reporter := reporters.NewPDFMissingTitleReporter()
+	if reporter.ErrorType != reporter_errors.ErrorPDFMissingTitle {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestPDFMissingTitleIssues: reportsIssue should be true")
+	}
+}
+
+// Test the PDFMissingLang reporter with a PDF document with a language.
+// The reporter should not report the issue.
+func TestPDFMissingLangNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "application/pdf",
+		StatusCode: 200,
+		Lang:       "en",
+	}
+
+	reporter := reporters.NewPDFMissingLangReporter()
+	if reporter.ErrorType != reporter_errors.ErrorPDFMissingLang {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestPDFMissingLangNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the PDFMissingLang reporter with a PDF document without a language.
+// The reporter should report the issue.
+func TestPDFMissingLangIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "application/pdf",
+		StatusCode: 200,
+	}
+
+	reporter := reporters.NewPDFMissingLangReporter()
+	if reporter.ErrorType != reporter_errors.ErrorPDFMissingLang {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestPDFMissingLangIssues: reportsIssue should be true")
+	}
+}
+
+// Test the PDFWithoutText reporter with a PDF document with text.
+// The reporter should not report the issue.
+func TestPDFWithoutTextNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "application/pdf",
+		StatusCode: 200,
+		Words:      120,
+		PDF:        models.PDF{Pages: 2},
+	}
+
+	reporter := reporters.NewPDFWithoutTextReporter()
+	if reporter.ErrorType != reporter_errors.ErrorPDFWithoutText {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestPDFWithoutTextNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the PDFWithoutText reporter with a scanned PDF document without text.
+// The reporter should report the issue.
+func TestPDFWithoutTextIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "application/pdf",
+		StatusCode: 200,
+		PDF:        models.PDF{Pages: 2},
+	}
+
+	reporter := reporters.NewPDFWithoutTextReporter()
+	if reporter.ErrorType != reporter_errors.ErrorPDFWithoutText {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestPDFWithoutTextIssues: reportsIssue should be true")
+	}
+}
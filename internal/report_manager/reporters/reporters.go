@@ -1,6 +1,7 @@
 package reporters
 
 import (
+	"github.com/stjudewashere/seonaut/internal/models"
 	"github.com/stjudewashere/seonaut/internal/report_manager"
 )
 
@@ -15,7 +16,7 @@ func GetAllReporters() []*report_manager.PageIssueReporter {
 		// Add title issue reporters
 		NewEmptyTitleReporter(),
 		NewShortTitleReporter(),
-		NewShortTitleReporter(),
+		NewLongTitleReporter(),
 
 		// Add description issue reporters
 		NewEmptyDescriptionReporter(),
@@ -28,6 +29,8 @@ func GetAllReporters() []*report_manager.PageIssueReporter {
 		NewNoIndexInSitemapReporter(),
 		NewSitemapAndBlockedReporter(),
 		NewNonCanonicalInSitemapReporter(),
+		NewRobotsConflictReporter(),
+		NewNoindexNonHTMLReporter(),
 
 		// Add link issue reporters
 		NewTooManyLinksReporter(),
@@ -35,9 +38,13 @@ func GetAllReporters() []*report_manager.PageIssueReporter {
 		NewExternalLinkWitoutNoFollowReporter(),
 		NewHTTPLinksReporter(),
 		NewDeadendReporter(),
+		NewEmptyAnchorTextReporter(),
 
 		// Add image issue reporters
 		NewAltTextReporter(),
+		NewImageMissingDimensionsReporter(),
+		NewAltTextTooLongReporter(),
+		NewAltTextFilenameReporter(),
 
 		// Add language issue reporters
 		NewInvalidLangReporter(),
@@ -46,11 +53,105 @@ func GetAllReporters() []*report_manager.PageIssueReporter {
 		// Add heading issue reporters
 		NewNoH1Reporter(),
 		NewValidHeadingsOrderReporter(),
+		NewMultipleH1Reporter(),
+		NewH1EqualsTitleReporter(),
+		NewEmptyHeadingsReporter(),
 
 		// Add content issue reporters
 		NewLittleContentReporter(),
 
+		// Add structured data issue reporters
+		NewInvalidStructuredDataReporter(),
+		NewStructuredDataMissingRequiredReporter(),
+		NewStructuredDataMissingRecommendedReporter(),
+
+		// Add social tags issue reporters
+		NewOGTitleMissingReporter(),
+		NewOGImageMissingReporter(),
+		NewOGURLCanonicalMismatchReporter(),
+		NewOGImageRelativeReporter(),
+
+		// Add page setup issue reporters
+		NewMissingViewportReporter(),
+		NewInvalidViewportReporter(),
+		NewCharsetMismatchReporter(),
+		NewMissingFaviconReporter(),
+
+		// Add head issue reporters
+		NewHeadBreakingElementReporter(),
+		NewDuplicateIdsReporter(),
+		NewMultipleTitleTagsReporter(),
+		NewMultipleCanonicalTagsReporter(),
+
+		// Add accessibility issue reporters
+		NewInputWithoutLabelReporter(),
+		NewButtonWithoutNameReporter(),
+		NewLinkWithoutNameReporter(),
+		NewPositiveTabindexReporter(),
+		NewIframeWithoutTitleReporter(),
+		NewImageWithoutTextAlternativeReporter(),
+		NewHTMLWithoutLangReporter(),
+
+		// Add hreflang issue reporters
+		NewInvalidHreflangCodeReporter(),
+		NewHreflangMissingSelfReferenceReporter(),
+		NewHreflangMissingXDefaultReporter(),
+		NewHreflangDuplicateLangReporter(),
+
+		// Add canonical issue reporters
+		NewConflictingCanonicalsReporter(),
+		NewCrossDomainCanonicalReporter(),
+		NewCanonicalWithParametersReporter(),
+
+		// Add security headers issue reporters
+		NewMissingHSTSReporter(),
+		NewInvalidHSTSReporter(),
+		NewMissingCSPReporter(),
+		NewCSPUnsafeInlineReporter(),
+		NewMissingXFrameOptionsReporter(),
+		NewMissingXContentTypeOptionsReporter(),
+		NewMissingReferrerPolicyReporter(),
+		NewUnsafeReferrerPolicyReporter(),
+		NewMissingPermissionsPolicyReporter(),
+
+		// Add caching issue reporters
+		NewStaticResourceShortCacheReporter(),
+		NewPublicCacheWithCookieReporter(),
+		NewMissingCacheValidatorsReporter(),
+
+		// Add PDF issue reporters
+		NewPDFMissingTitleReporter(),
+		NewPDFMissingLangReporter(),
+		NewPDFWithoutTextReporter(),
+
 		// Add scheme issue reporters
 		NewHTTPSchemeReporter(),
 	}
 }
+
+// Returns true if the page has been crawled and the status code is between 200 and 299.
+func isSuccessful(pageReport *models.PageReport) bool {
+	if pageReport.Crawled == false {
+		return false
+	}
+
+	return pageReport.StatusCode >= 200 && pageReport.StatusCode < 300
+}
+
+// Returns true if the page has been crawled, the media type is text/html
+// and the status code is between 200 and 299.
+func isSuccessfulHTML(pageReport *models.PageReport) bool {
+	return isSuccessful(pageReport) && pageReport.MediaType == "text/html"
+}
+
+// Returns true if the page has been crawled, the media type is application/pdf
+// and the status code is between 200 and 299.
+func isSuccessfulPDF(pageReport *models.PageReport) bool {
+	return isSuccessful(pageReport) && pageReport.MediaType == "application/pdf"
+}
+
+// Returns true if the page has been crawled, it is an HTML page with a 20x status code
+// and it is indexable.
+func isIndexableHTML(pageReport *models.PageReport) bool {
+	return isSuccessfulHTML(pageReport) && !pageReport.Noindex
+}
@@ -0,0 +1,159 @@
+package reporters_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporters"
+	"github.com/stjudewashere/seonaut/internal/report_manager/sql_reporters"
+)
+
+// failingDriver is a sql driver that can't open connections, so the sql reporters
+// return their error type without reporting any issue.
+type failingDriver struct{}
+
+func (d failingDriver) Open(name string) (driver.Conn, error) {
+	return nil, errors.New("failingDriver: connection not available")
+}
+
+func init() {
+	sql.Register("failing", failingDriver{})
+}
+
+// Test all the page reporters use an issue type declared in the issue types registry,
+// and that each issue type is reported by a single page reporter.
+func TestReportersAreRegistered(t *testing.T) {
+	seen := make(map[int]bool)
+	for _, r := range reporters.GetAllReporters() {
+		if _, ok := reporter_errors.GetIssueType(r.ErrorType); !ok {
+			t.Errorf("TestReportersAreRegistered: error type %d is not registered", r.ErrorType)
+		}
+
+		if seen[r.ErrorType] {
+			t.Errorf("TestReportersAreRegistered: error type %d is reported more than once", r.ErrorType)
+		}
+		seen[r.ErrorType] = true
+	}
+}
+
+// Test the page reporters whose result changes with the thresholds have UsesThresholds set,
+// so their issues are re-evaluated when the project's thresholds change.
+func TestThresholdReportersAreFlagged(t *testing.T) {
+	u, _ := url.Parse("https://example.com/")
+	links := []models.Link{}
+	for i := 0; i < 50; i++ {
+		links = append(links, models.Link{URL: u.String(), ParsedURL: u})
+	}
+
+	pageReports := []*models.PageReport{
+		{
+			URL:         u.String(),
+			ParsedURL:   u,
+			Crawled:     true,
+			MediaType:   "text/html",
+			StatusCode:  200,
+			Title:       "A title of average length for the page",
+			Description: "A description of average length for the page, long enough to be a description.",
+			Words:       150,
+			Links:       links,
+		},
+	}
+
+	low := &models.Thresholds{}
+	high := &models.Thresholds{
+		TitleMinLength:       1000,
+		TitleMaxLength:       1000,
+		DescriptionMinLength: 1000,
+		DescriptionMaxLength: 1000,
+		MaxLinks:             1000,
+		MinWords:             1000,
+		MaxImageSize:         1000,
+		DuplicateThreshold:   100,
+	}
+
+	for _, r := range reporters.GetAllReporters() {
+		for _, p := range pageReports {
+			if r.Callback(p, low) != r.Callback(p, high) && !r.UsesThresholds {
+				t.Errorf("TestThresholdReportersAreFlagged: error type %d uses the thresholds", r.ErrorType)
+			}
+		}
+	}
+}
+
+// Test every threshold changes the result of at least one of the registered page reporters.
+// The thresholds used by the sql reporters are read from the project in the database, so
+// they are checked by the sql threshold reporters instead.
+func TestThresholdsAreUsed(t *testing.T) {
+	sqlThresholds := map[string]bool{
+		"MaxImageSize":       true, // OversizedImagesReporter
+		"DuplicateThreshold": true, // DuplicatedContentReporter through the duplicate clusters
+	}
+
+	u, _ := url.Parse("https://example.com/")
+	links := []models.Link{}
+	for i := 0; i < 50; i++ {
+		links = append(links, models.Link{URL: u.String(), ParsedURL: u})
+	}
+
+	pageReport := &models.PageReport{
+		URL:         u.String(),
+		ParsedURL:   u,
+		Crawled:     true,
+		MediaType:   "text/html",
+		StatusCode:  200,
+		Title:       "A title of average length for the page",
+		Description: "A description of average length for the page, long enough to be a description.",
+		Words:       150,
+		Links:       links,
+	}
+
+	fields := reflect.TypeOf(models.Thresholds{})
+	for i := 0; i < fields.NumField(); i++ {
+		name := fields.Field(i).Name
+		if sqlThresholds[name] {
+			continue
+		}
+
+		low := models.NewThresholds()
+		high := models.NewThresholds()
+		reflect.ValueOf(low).Elem().Field(i).SetInt(0)
+		reflect.ValueOf(high).Elem().Field(i).SetInt(1000)
+
+		used := false
+		for _, r := range reporters.GetAllReporters() {
+			if r.Callback(pageReport, low) != r.Callback(pageReport, high) {
+				used = true
+			}
+		}
+
+		if !used {
+			t.Errorf("TestThresholdsAreUsed: threshold %s is not used by any registered reporter", name)
+		}
+	}
+}
+
+// Test all the sql reporters use an issue type declared in the issue types registry.
+func TestSqlReportersAreRegistered(t *testing.T) {
+	db, err := sql.Open("failing", "")
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	defer db.Close()
+
+	sr := sql_reporters.NewSqlReporter(db)
+	for _, callback := range append(sr.GetAllReporters(), sr.GetThresholdReporters()...) {
+		r := callback(&models.Crawl{})
+		for range r.Pstream {
+		}
+
+		if _, ok := reporter_errors.GetIssueType(r.ErrorType); !ok {
+			t.Errorf("TestSqlReportersAreRegistered: error type %d is not registered", r.ErrorType)
+		}
+	}
+}
@@ -9,12 +9,8 @@ import (
 // Returns a report_manager.PageIssueReporter with a callback function that checks if page uses the http
 // scheme instead of https. The callback function returns true has a 20x status code and uses http scheme.
 func NewHTTPSchemeReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
-			return false
-		}
-
-		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessful(pageReport) {
 			return false
 		}
 
@@ -34,7 +34,7 @@ func TestHTTPSchemeNoIssues(t *testing.T) {
 	}
 
 	// Run the reporter callback with the PageReport.
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	// The reporter should not found any issue.
 	if reportsIssue == true {
@@ -67,7 +67,7 @@ func TestHTTPSchemeIssues(t *testing.T) {
 	}
 
 	// Run the reporter callback with the PageReport.
-	reportsIssue := reporter.Callback(pageReport)
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
 
 	// The reporter should found an issue.
 	if reportsIssue == false {
@@ -0,0 +1,267 @@
+package reporters
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// Referrer policies supported by the browsers.
+var referrerPolicies = map[string]bool{
+	"no-referrer":                     true,
+	"no-referrer-when-downgrade":      true,
+	"origin":                          true,
+	"origin-when-cross-origin":        true,
+	"same-origin":                     true,
+	"strict-origin":                   true,
+	"strict-origin-when-cross-origin": true,
+	"unsafe-url":                      true,
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page is
+// served over HTTPS without the Strict-Transport-Security header.
+func NewMissingHSTSReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) || !strings.HasPrefix(pageReport.URL, "https://") {
+			return false
+		}
+
+		return pageReport.SecurityHeaders.StrictTransportSecurity == ""
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorMissingHSTS,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page is
+// served over HTTPS with a Strict-Transport-Security header without a max-age greater than 0.
+func NewInvalidHSTSReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) || !strings.HasPrefix(pageReport.URL, "https://") {
+			return false
+		}
+
+		hsts := pageReport.SecurityHeaders.StrictTransportSecurity
+		if hsts == "" {
+			return false
+		}
+
+		return hstsMaxAge(hsts) <= 0
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorInvalidHSTS,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page doesn't
+// have a Content-Security-Policy header.
+func NewMissingCSPReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.SecurityHeaders.ContentSecurityPolicy == ""
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorMissingCSP,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page's
+// Content-Security-Policy allows 'unsafe-inline' in a directive without nonces or hashes,
+// which would make browsers ignore it.
+func NewCSPUnsafeInlineReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		for _, sources := range cspDirectives(pageReport.SecurityHeaders.ContentSecurityPolicy) {
+			unsafeInline, nonceOrHash := false, false
+			for _, s := range sources {
+				switch {
+				case s == "'unsafe-inline'":
+					unsafeInline = true
+				case strings.HasPrefix(s, "'nonce-"), strings.HasPrefix(s, "'sha"):
+					nonceOrHash = true
+				}
+			}
+
+			if unsafeInline && !nonceOrHash {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorCSPUnsafeInline,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page is not
+// protected against clickjacking, either with a DENY or SAMEORIGIN X-Frame-Options header
+// or with the frame-ancestors directive of the Content-Security-Policy.
+func NewMissingXFrameOptionsReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		if _, ok := cspDirectives(pageReport.SecurityHeaders.ContentSecurityPolicy)["frame-ancestors"]; ok {
+			return false
+		}
+
+		xfo := strings.ToLower(pageReport.SecurityHeaders.XFrameOptions)
+
+		return xfo != "deny" && xfo != "sameorigin"
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorMissingXFrameOptions,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page doesn't
+// have the X-Content-Type-Options header set to nosniff.
+func NewMissingXContentTypeOptionsReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return strings.ToLower(pageReport.SecurityHeaders.XContentTypeOptions) != "nosniff"
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorMissingXContentTypeOptions,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page doesn't
+// have a Referrer-Policy header with a policy supported by the browsers.
+func NewMissingReferrerPolicyReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return referrerPolicy(pageReport.SecurityHeaders.ReferrerPolicy) == ""
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorMissingReferrerPolicy,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page's
+// Referrer-Policy is unsafe-url, which sends the full URL to any origin, even over HTTP.
+func NewUnsafeReferrerPolicyReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return referrerPolicy(pageReport.SecurityHeaders.ReferrerPolicy) == "unsafe-url"
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorUnsafeReferrerPolicy,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page doesn't
+// have a Permissions-Policy header.
+func NewMissingPermissionsPolicyReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.SecurityHeaders.PermissionsPolicy == ""
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorMissingPermissionsPolicy,
+		Callback:  c,
+	}
+}
+
+// Returns the max-age in seconds of a Strict-Transport-Security header, or -1 if it
+// doesn't have a valid max-age directive.
+// ex. "max-age=31536000; includeSubDomains" returns 31536000.
+func hstsMaxAge(hsts string) int64 {
+	for _, d := range strings.Split(hsts, ";") {
+		kv := strings.SplitN(strings.TrimSpace(d), "=", 2)
+		if len(kv) != 2 || strings.ToLower(strings.TrimSpace(kv[0])) != "max-age" {
+			continue
+		}
+
+		v, err := strconv.ParseInt(strings.Trim(strings.TrimSpace(kv[1]), `"`), 10, 64)
+		if err != nil {
+			return -1
+		}
+
+		return v
+	}
+
+	return -1
+}
+
+// Returns the lowercased directives of a Content-Security-Policy with their sources.
+// Only the first occurrence of a directive is used, as browsers do.
+// ex. "script-src 'self' 'unsafe-inline'" returns {"script-src": ["'self'", "'unsafe-inline'"]}.
+func cspDirectives(csp string) map[string][]string {
+	directives := make(map[string][]string)
+	for _, d := range strings.Split(strings.ToLower(csp), ";") {
+		fields := strings.Fields(d)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if _, ok := directives[fields[0]]; !ok {
+			directives[fields[0]] = fields[1:]
+		}
+	}
+
+	return directives
+}
+
+// Returns the Referrer-Policy used by the browsers, which is the last policy
+// they support in the header, or an empty string if there's none.
+func referrerPolicy(header string) string {
+	policy := ""
+	for _, p := range strings.Split(header, ",") {
+		p = strings.ToLower(strings.TrimSpace(p))
+		if referrerPolicies[p] {
+			policy = p
+		}
+	}
+
+	return policy
+}
@@ -0,0 +1,387 @@
+package reporters_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporters"
+)
+
+// Test the MissingHSTS reporter with a pageReport with the Strict-Transport-Security header.
+// The reporter should not report the issue.
+func TestMissingHSTSNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{StrictTransportSecurity: "max-age=31536000"},
+	}
+
+	reporter := reporters.NewMissingHSTSReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingHSTS {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestMissingHSTSNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingHSTS reporter with a pageReport without the Strict-Transport-Security header.
+// The reporter should report the issue.
+func TestMissingHSTSIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{},
+	}
+
+	reporter := reporters.NewMissingHSTSReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingHSTS {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestMissingHSTSIssues: reportsIssue should be true")
+	}
+}
+
+// Test the InvalidHSTS reporter with a pageReport with a valid HSTS max-age.
+// The reporter should not report the issue.
+func TestInvalidHSTSNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{StrictTransportSecurity: "max-age=31536000; includeSubDomains"},
+	}
+
+	reporter := reporters.NewInvalidHSTSReporter()
+	if reporter.ErrorType != reporter_errors.ErrorInvalidHSTS {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestInvalidHSTSNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the InvalidHSTS reporter with a pageReport with an HSTS header without max-age.
+// The reporter should report the issue.
+func TestInvalidHSTSIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{StrictTransportSecurity: "includeSubDomains"},
+	}
+
+	reporter := reporters.NewInvalidHSTSReporter()
+	if reporter.ErrorType != reporter_errors.ErrorInvalidHSTS {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestInvalidHSTSIssues: reportsIssue should be true")
+	}
+}
+
+// Test the MissingCSP reporter with a pageReport with a Content-Security-Policy.
+// The reporter should not report the issue.
+func TestMissingCSPNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{ContentSecurityPolicy: "default-src 'self'"},
+	}
+
+	reporter := reporters.NewMissingCSPReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingCSP {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestMissingCSPNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingCSP reporter with a pageReport without a Content-Security-Policy.
+// The reporter should report the issue.
+func TestMissingCSPIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{},
+	}
+
+	reporter := reporters.NewMissingCSPReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingCSP {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestMissingCSPIssues: reportsIssue should be true")
+	}
+}
+
+// Test the CSPUnsafeInline reporter with a pageReport with a CSP using unsafe-inline along with a nonce.
+// The reporter should not report the issue.
+func TestCSPUnsafeInlineNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{ContentSecurityPolicy: "script-src 'self' 'unsafe-inline' 'nonce-abc123'"},
+	}
+
+	reporter := reporters.NewCSPUnsafeInlineReporter()
+	if reporter.ErrorType != reporter_errors.ErrorCSPUnsafeInline {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestCSPUnsafeInlineNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the CSPUnsafeInline reporter with a pageReport with a CSP allowing unsafe-inline scripts.
+// The reporter should report the issue.
+func TestCSPUnsafeInlineIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{ContentSecurityPolicy: "default-src 'self'; script-src 'self' 'unsafe-inline'"},
+	}
+
+	reporter := reporters.NewCSPUnsafeInlineReporter()
+	if reporter.ErrorType != reporter_errors.ErrorCSPUnsafeInline {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestCSPUnsafeInlineIssues: reportsIssue should be true")
+	}
+}
+
+// Test the MissingXFrameOptions reporter with a pageReport with the CSP frame-ancestors directive.
+// The reporter should not report the issue.
+func TestMissingXFrameOptionsNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{ContentSecurityPolicy: "frame-ancestors 'none'"},
+	}
+
+	reporter := reporters.NewMissingXFrameOptionsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingXFrameOptions {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestMissingXFrameOptionsNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingXFrameOptions reporter with a pageReport with an invalid X-Frame-Options value.
+// The reporter should report the issue.
+func TestMissingXFrameOptionsIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{XFrameOptions: "ALLOW-FROM https://example.org/"},
+	}
+
+	reporter := reporters.NewMissingXFrameOptionsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingXFrameOptions {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestMissingXFrameOptionsIssues: reportsIssue should be true")
+	}
+}
+
+// Test the MissingXContentTypeOptions reporter with a pageReport with the nosniff X-Content-Type-Options.
+// The reporter should not report the issue.
+func TestMissingXContentTypeOptionsNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{XContentTypeOptions: "nosniff"},
+	}
+
+	reporter := reporters.NewMissingXContentTypeOptionsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingXContentTypeOptions {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestMissingXContentTypeOptionsNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingXContentTypeOptions reporter with a pageReport without the X-Content-Type-Options header.
+// The reporter should report the issue.
+func TestMissingXContentTypeOptionsIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{},
+	}
+
+	reporter := reporters.NewMissingXContentTypeOptionsReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingXContentTypeOptions {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestMissingXContentTypeOptionsIssues: reportsIssue should be true")
+	}
+}
+
+// Test the MissingReferrerPolicy reporter with a pageReport with a fallback Referrer-Policy.
+// The reporter should not report the issue.
+func TestMissingReferrerPolicyNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{ReferrerPolicy: "no-referrer, strict-origin-when-cross-origin"},
+	}
+
+	reporter := reporters.NewMissingReferrerPolicyReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingReferrerPolicy {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestMissingReferrerPolicyNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingReferrerPolicy reporter with a pageReport with an unknown Referrer-Policy.
+// The reporter should report the issue.
+func TestMissingReferrerPolicyIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{ReferrerPolicy: "never"},
+	}
+
+	reporter := reporters.NewMissingReferrerPolicyReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingReferrerPolicy {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestMissingReferrerPolicyIssues: reportsIssue should be true")
+	}
+}
+
+// Test the UnsafeReferrerPolicy reporter with a pageReport with the strict-origin Referrer-Policy.
+// The reporter should not report the issue.
+func TestUnsafeReferrerPolicyNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{ReferrerPolicy: "strict-origin"},
+	}
+
+	reporter := reporters.NewUnsafeReferrerPolicyReporter()
+	if reporter.ErrorType != reporter_errors.ErrorUnsafeReferrerPolicy {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestUnsafeReferrerPolicyNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the UnsafeReferrerPolicy reporter with a pageReport with the unsafe-url Referrer-Policy.
+// The reporter should report the issue.
+func TestUnsafeReferrerPolicyIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{ReferrerPolicy: "unsafe-url"},
+	}
+
+	reporter := reporters.NewUnsafeReferrerPolicyReporter()
+	if reporter.ErrorType != reporter_errors.ErrorUnsafeReferrerPolicy {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestUnsafeReferrerPolicyIssues: reportsIssue should be true")
+	}
+}
+
+// Test the MissingPermissionsPolicy reporter with a pageReport with a Permissions-Policy.
+// The reporter should not report the issue.
+func TestMissingPermissionsPolicyNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{PermissionsPolicy: "geolocation=()"},
+	}
+
+	reporter := reporters.NewMissingPermissionsPolicyReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingPermissionsPolicy {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == true {
+		t.Errorf("TestMissingPermissionsPolicyNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingPermissionsPolicy reporter with a pageReport without a Permissions-Policy.
+// The reporter should report the issue.
+func TestMissingPermissionsPolicyIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:         true,
+		MediaType:       "text/html",
+		StatusCode:      200,
+		URL:             "https://example.com/",
+		SecurityHeaders: models.SecurityHeaders{},
+	}
+
+	reporter := reporters.NewMissingPermissionsPolicyReporter()
+	if reporter.ErrorType != reporter_errors.ErrorMissingPermissionsPolicy {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	if reporter.Callback(pageReport, models.NewThresholds()) == false {
+		t.Errorf("TestMissingPermissionsPolicyIssues: reportsIssue should be true")
+	}
+}
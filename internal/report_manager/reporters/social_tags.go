@@ -0,0 +1,92 @@
+package reporters
+
+import (
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page doesn't have the og:title meta tag.
+func NewOGTitleMissingReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isIndexableHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.SocialTags.OGTitle == ""
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorOGTitleMissing,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page doesn't have the og:image meta tag.
+func NewOGImageMissingReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isIndexableHTML(pageReport) {
+			return false
+		}
+
+		return pageReport.SocialTags.OGImage == ""
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorOGImageMissing,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the og:url meta tag doesn't match the page's canonical URL. If the page doesn't have a
+// canonical URL the og:url is compared with the page's URL.
+func NewOGURLCanonicalMismatchReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isIndexableHTML(pageReport) {
+			return false
+		}
+
+		if pageReport.SocialTags.OGURL == "" {
+			return false
+		}
+
+		canonical := pageReport.Canonical
+		if canonical == "" {
+			canonical = pageReport.URL
+		}
+
+		return pageReport.SocialTags.OGURL != canonical
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorOGURLCanonicalMismatch,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the og:image meta tag contains a relative URL. Social networks require absolute URLs.
+func NewOGImageRelativeReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isIndexableHTML(pageReport) {
+			return false
+		}
+
+		image := strings.ToLower(pageReport.SocialTags.OGImage)
+		if image == "" {
+			return false
+		}
+
+		return !strings.HasPrefix(image, "http://") && !strings.HasPrefix(image, "https://")
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorOGImageRelative,
+		Callback:  c,
+	}
+}
@@ -0,0 +1,186 @@
+package reporters_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporters"
+)
+
+// Test the OGTitleMissing reporter with a pageReport that has the og:title tag.
+// The reporter should not report the issue.
+func TestOGTitleMissingNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		SocialTags: models.SocialTags{OGTitle: "Title"},
+	}
+
+	reporter := reporters.NewOGTitleMissingReporter()
+	if reporter.ErrorType != reporter_errors.ErrorOGTitleMissing {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestOGTitleMissingNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the OGTitleMissing reporter with a pageReport without the og:title tag.
+// The reporter should report the issue.
+func TestOGTitleMissingIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := reporters.NewOGTitleMissingReporter()
+	if reporter.ErrorType != reporter_errors.ErrorOGTitleMissing {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestOGTitleMissingIssues: reportsIssue should be true")
+	}
+}
+
+// Test the OGImageMissing reporter with a pageReport that has the og:image tag.
+// The reporter should not report the issue.
+func TestOGImageMissingNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		SocialTags: models.SocialTags{OGImage: "https://example.com/image.jpg"},
+	}
+
+	reporter := reporters.NewOGImageMissingReporter()
+	if reporter.ErrorType != reporter_errors.ErrorOGImageMissing {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestOGImageMissingNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the OGImageMissing reporter with a pageReport without the og:image tag.
+// The reporter should report the issue.
+func TestOGImageMissingIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := reporters.NewOGImageMissingReporter()
+	if reporter.ErrorType != reporter_errors.ErrorOGImageMissing {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestOGImageMissingIssues: reportsIssue should be true")
+	}
+}
+
+// Test the OGURLCanonicalMismatch reporter with a pageReport with an og:url matching the canonical.
+// The reporter should not report the issue.
+func TestOGURLCanonicalMismatchNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/page?ref=1",
+		Canonical:  "https://example.com/page",
+		SocialTags: models.SocialTags{OGURL: "https://example.com/page"},
+	}
+
+	reporter := reporters.NewOGURLCanonicalMismatchReporter()
+	if reporter.ErrorType != reporter_errors.ErrorOGURLCanonicalMismatch {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestOGURLCanonicalMismatchNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the OGURLCanonicalMismatch reporter with a pageReport with an og:url different from the canonical.
+// The reporter should report the issue.
+func TestOGURLCanonicalMismatchIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/page",
+		SocialTags: models.SocialTags{OGURL: "https://example.com/"},
+	}
+
+	reporter := reporters.NewOGURLCanonicalMismatchReporter()
+	if reporter.ErrorType != reporter_errors.ErrorOGURLCanonicalMismatch {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestOGURLCanonicalMismatchIssues: reportsIssue should be true")
+	}
+}
+
+// Test the OGImageRelative reporter with a pageReport with an absolute og:image URL.
+// The reporter should not report the issue.
+func TestOGImageRelativeNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		SocialTags: models.SocialTags{OGImage: "https://example.com/image.jpg"},
+	}
+
+	reporter := reporters.NewOGImageRelativeReporter()
+	if reporter.ErrorType != reporter_errors.ErrorOGImageRelative {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestOGImageRelativeNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the OGImageRelative reporter with a pageReport with a relative og:image URL.
+// The reporter should report the issue.
+func TestOGImageRelativeIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		SocialTags: models.SocialTags{OGImage: "/image.jpg"},
+	}
+
+	reporter := reporters.NewOGImageRelativeReporter()
+	if reporter.ErrorType != reporter_errors.ErrorOGImageRelative {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestOGImageRelativeIssues: reportsIssue should be true")
+	}
+}
@@ -9,7 +9,7 @@ import (
 // Returns a new report_manager.PageIssueReporter with a callback function that
 // checks if the status code is in the 30x range.
 func NewStatus30xReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 		if pageReport.Crawled == false {
 			return false
 		}
@@ -26,7 +26,7 @@ func NewStatus30xReporter() *report_manager.PageIssueReporter {
 // Returns a new report_manager.PageIssueReporter with a callback function that
 // checks if the status code is in the 40x range.
 func NewStatus40xReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 		if pageReport.Crawled == false {
 			return false
 		}
@@ -43,7 +43,7 @@ func NewStatus40xReporter() *report_manager.PageIssueReporter {
 // Returns a new report_manager.PageIssueReporter with a callback function that
 // checks if the status code is greater or equal than 500.
 func NewStatus50xReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
 		if pageReport.Crawled == false {
 			return false
 		}
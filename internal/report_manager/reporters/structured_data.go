@@ -0,0 +1,166 @@
+package reporters
+
+import (
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// schemaProperties contains the required and recommended properties of the validated schema.org types.
+// At least one of the requiredOneOf properties is also required, if there are any.
+type schemaProperties struct {
+	required      []string
+	requiredOneOf []string
+	recommended   []string
+}
+
+// Validated schema.org types. Subtypes, such as NewsArticle, share the properties of their parent type.
+var schemaTypes = map[string]schemaProperties{
+	"Product": {
+		required:      []string{"name"},
+		requiredOneOf: []string{"offers", "review", "aggregateRating"},
+		recommended:   []string{"image", "description", "brand"},
+	},
+	"Article": {
+		required:    []string{"headline"},
+		recommended: []string{"image", "author", "datePublished", "dateModified"},
+	},
+	"BreadcrumbList": {
+		required: []string{"itemListElement"},
+	},
+	"FAQPage": {
+		required: []string{"mainEntity"},
+	},
+	"Organization": {
+		required:    []string{"name"},
+		recommended: []string{"url", "logo", "sameAs"},
+	},
+}
+
+// Schema.org subtypes validated as their parent type.
+var schemaSubtypes = map[string]string{
+	"NewsArticle":   "Article",
+	"BlogPosting":   "Article",
+	"Corporation":   "Organization",
+	"LocalBusiness": "Organization",
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page has structured data that could not be parsed, such as JSON-LD scripts with invalid JSON.
+func NewInvalidStructuredDataReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		for _, sd := range pageReport.StructuredData {
+			if !sd.Valid {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorInvalidStructuredData,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page has structured data items missing any of the required properties of its schema.org type,
+// or missing all of the properties of which at least one is required.
+func NewStructuredDataMissingRequiredReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		for _, sd := range pageReport.StructuredData {
+			if !sd.Valid {
+				continue
+			}
+
+			properties := schemaPropertiesFor(sd.Type)
+			if missingProperties(&sd, properties.required) {
+				return true
+			}
+
+			if len(properties.requiredOneOf) > 0 && !hasAnyProperty(&sd, properties.requiredOneOf) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorStructuredDataMissingRequired,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page has structured data items missing any of the recommended properties of its schema.org type.
+func NewStructuredDataMissingRecommendedReporter() *report_manager.PageIssueReporter {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
+			return false
+		}
+
+		for _, sd := range pageReport.StructuredData {
+			if sd.Valid && missingProperties(&sd, schemaPropertiesFor(sd.Type).recommended) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &report_manager.PageIssueReporter{
+		ErrorType: reporter_errors.ErrorStructuredDataMissingRecommended,
+		Callback:  c,
+	}
+}
+
+// Returns the properties of a schema.org type. Types that are not validated have no properties.
+func schemaPropertiesFor(t string) schemaProperties {
+	if parent, ok := schemaSubtypes[t]; ok {
+		t = parent
+	}
+
+	return schemaTypes[t]
+}
+
+// Returns true if any of the properties is missing in the structured data item.
+func missingProperties(sd *models.StructuredData, properties []string) bool {
+	for _, p := range properties {
+		found := false
+		for _, sp := range sd.Properties {
+			if sp == p {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns true if any of the properties is in the structured data item.
+func hasAnyProperty(sd *models.StructuredData, properties []string) bool {
+	for _, p := range properties {
+		for _, sp := range sd.Properties {
+			if sp == p {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,199 @@
+package reporters_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporters"
+)
+
+// Test the InvalidStructuredData reporter with a pageReport with valid structured data.
+// The reporter should not report the issue.
+func TestInvalidStructuredDataNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		StructuredData: []models.StructuredData{
+			{Format: "json-ld", Type: "Organization", Valid: true},
+		},
+	}
+
+	reporter := reporters.NewInvalidStructuredDataReporter()
+	if reporter.ErrorType != reporter_errors.ErrorInvalidStructuredData {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestInvalidStructuredDataNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the InvalidStructuredData reporter with a pageReport with invalid structured data.
+// The reporter should report the issue.
+func TestInvalidStructuredDataIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		StructuredData: []models.StructuredData{
+			{Format: "json-ld", Valid: false},
+		},
+	}
+
+	reporter := reporters.NewInvalidStructuredDataReporter()
+	if reporter.ErrorType != reporter_errors.ErrorInvalidStructuredData {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestInvalidStructuredDataIssues: reportsIssue should be true")
+	}
+}
+
+// Test the StructuredDataMissingRequired reporter with a pageReport with a complete Product.
+// The reporter should not report the issue.
+func TestStructuredDataMissingRequiredNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		StructuredData: []models.StructuredData{
+			{Format: "microdata", Type: "Product", Properties: []string{"name", "offers"}, Valid: true},
+			{Format: "json-ld", Type: "WebSite", Valid: true},
+		},
+	}
+
+	reporter := reporters.NewStructuredDataMissingRequiredReporter()
+	if reporter.ErrorType != reporter_errors.ErrorStructuredDataMissingRequired {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestStructuredDataMissingRequiredNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the StructuredDataMissingRequired reporter with a pageReport with a NewsArticle without headline.
+// The reporter should report the issue.
+func TestStructuredDataMissingRequiredIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		StructuredData: []models.StructuredData{
+			{Format: "json-ld", Type: "NewsArticle", Properties: []string{"author", "image"}, Valid: true},
+		},
+	}
+
+	reporter := reporters.NewStructuredDataMissingRequiredReporter()
+	if reporter.ErrorType != reporter_errors.ErrorStructuredDataMissingRequired {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestStructuredDataMissingRequiredIssues: reportsIssue should be true")
+	}
+}
+
+// Test the StructuredDataMissingRequired reporter with a pageReport with a Product without offers,
+// review or aggregateRating. The reporter should report the issue.
+func TestStructuredDataMissingRequiredOneOfIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		StructuredData: []models.StructuredData{
+			{Format: "json-ld", Type: "Product", Properties: []string{"brand", "name"}, Valid: true},
+		},
+	}
+
+	reporter := reporters.NewStructuredDataMissingRequiredReporter()
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestStructuredDataMissingRequiredOneOfIssues: reportsIssue should be true")
+	}
+}
+
+// Test the StructuredDataMissingRecommended reporter with a pageReport with a complete Organization.
+// The reporter should not report the issue.
+func TestStructuredDataMissingRecommendedNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		StructuredData: []models.StructuredData{
+			{Format: "rdfa", Type: "Organization", Properties: []string{"logo", "name", "sameAs", "url"}, Valid: true},
+		},
+	}
+
+	reporter := reporters.NewStructuredDataMissingRecommendedReporter()
+	if reporter.ErrorType != reporter_errors.ErrorStructuredDataMissingRecommended {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == true {
+		t.Errorf("TestStructuredDataMissingRecommendedNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the StructuredDataMissingRecommended reporter with a pageReport with an Organization without logo.
+// The reporter should report the issue.
+func TestStructuredDataMissingRecommendedIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		StructuredData: []models.StructuredData{
+			{Format: "rdfa", Type: "Organization", Properties: []string{"name", "sameAs", "url"}, Valid: true},
+		},
+	}
+
+	reporter := reporters.NewStructuredDataMissingRecommendedReporter()
+	if reporter.ErrorType != reporter_errors.ErrorStructuredDataMissingRecommended {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, models.NewThresholds())
+
+	if reportsIssue == false {
+		t.Errorf("TestStructuredDataMissingRecommendedIssues: reportsIssue should be true")
+	}
+}
+
+// Test the structured data reporters with a pageReport with invalid structured data and
+// missing properties that returns a 404 status code. The reporters should not report the issues.
+func TestStructuredDataErrorStatusNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 404,
+		StructuredData: []models.StructuredData{
+			{Format: "json-ld", Valid: false},
+			{Format: "json-ld", Type: "Product", Valid: true},
+		},
+	}
+
+	for _, reporter := range []*report_manager.PageIssueReporter{
+		reporters.NewInvalidStructuredDataReporter(),
+		reporters.NewStructuredDataMissingRequiredReporter(),
+		reporters.NewStructuredDataMissingRecommendedReporter(),
+	} {
+		if reporter.Callback(pageReport, models.NewThresholds()) {
+			t.Errorf("TestStructuredDataErrorStatusNoIssues: error type %d reportsIssue should be false", reporter.ErrorType)
+		}
+	}
+}
@@ -10,16 +10,8 @@ import (
 // The callback function returns true if the page is text/html, has a 20x status code
 // and has an empty or missing title.
 func NewEmptyTitleReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
-			return false
-		}
-
-		if pageReport.MediaType != "text/html" {
-			return false
-		}
-
-		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
@@ -33,45 +25,39 @@ func NewEmptyTitleReporter() *report_manager.PageIssueReporter {
 }
 
 // Returns a report_manager.PageIssueReporter with a callback function that checks if the page has a short title.
-// The callback returns true if the page is text/html and has a page title shorter than an specified
-// amount of letters.
+// The callback returns true if the page is text/html with a 20x status code and has a page title shorter than the
+// project's minimum title length.
 func NewShortTitleReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
-			return false
-		}
-
-		if pageReport.MediaType != "text/html" {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		return len(pageReport.Title) > 0 && len(pageReport.Title) < 20
+		return len(pageReport.Title) > 0 && len(pageReport.Title) < thresholds.TitleMinLength
 	}
 
 	return &report_manager.PageIssueReporter{
-		ErrorType: reporter_errors.ErrorShortTitle,
-		Callback:  c,
+		ErrorType:      reporter_errors.ErrorShortTitle,
+		Callback:       c,
+		UsesThresholds: true,
 	}
 }
 
 // Returns a report_manager.PageIssueReporter with a callback function that checks if the page has a long title.
-// The callback function returns true if the page is text/html and has a page title longer than an
-// specified amount of letters.
+// The callback function returns true if the page is text/html with a 20x status code and has a page title longer than the
+// project's maximum title length.
 func NewLongTitleReporter() *report_manager.PageIssueReporter {
-	c := func(pageReport *models.PageReport) bool {
-		if pageReport.Crawled == false {
+	c := func(pageReport *models.PageReport, thresholds *models.Thresholds) bool {
+		if !isSuccessfulHTML(pageReport) {
 			return false
 		}
 
-		if pageReport.MediaType != "text/html" {
-			return false
-		}
-
-		return len(pageReport.Title) > 60
+		return len(pageReport.Title) > thresholds.TitleMaxLength
 	}
 
 	return &report_manager.PageIssueReporter{
-		ErrorType: reporter_errors.ErrorLongTitle,
-		Callback:  c,
+		ErrorType:      reporter_errors.ErrorLongTitle,
+		Callback:       c,
+		UsesThresholds: true,
 	}
 }
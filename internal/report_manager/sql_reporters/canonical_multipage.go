@@ -7,13 +7,15 @@ import (
 )
 
 // Creates a MultipageIssueReporter object that contains the SQL query to check for pages
-// that are canonicalized to non-canonical pages.
+// that are canonicalized to non-canonical pages. In a canonical chain (A→B→C) this reports
+// the page in the middle of the chain (B), while the CanonicalChainReporter reports the
+// pages canonicalized to it (A).
 func (sr *SqlReporter) CanonicalizedToNonCanonical(c *models.Crawl) *report_manager.MultipageIssueReporter {
 	query := `
 		SELECT
 			a.id
 		FROM pagereports AS a
-		INNER JOIN pagereports AS b ON a.url = b.canonical
+		INNER JOIN pagereports AS b ON a.url_hash = b.canonical_hash
 		WHERE a.crawl_id = ?
 			AND b.crawl_id = ?
 			AND a.canonical != ""
@@ -55,7 +57,7 @@ func (sr *SqlReporter) CanonicalizedToRedirect(c *models.Crawl) *report_manager.
 		SELECT
 			pr.id
 		FROM pagereports AS pr
-		INNER JOIN pagereports AS pr2 ON pr.canonical = pr2.url
+		INNER JOIN pagereports AS pr2 ON pr.canonical_hash = pr2.url_hash
 		WHERE pr.crawl_id = ?
 			AND pr2.crawl_id = ?
 			AND pr.canonical != pr.url
@@ -63,7 +65,7 @@ func (sr *SqlReporter) CanonicalizedToRedirect(c *models.Crawl) *report_manager.
 			AND pr2.status_code < 400;`
 
 	return &report_manager.MultipageIssueReporter{
-		Pstream:   sr.pageReportsQuery(query, c.Id),
+		Pstream:   sr.pageReportsQuery(query, c.Id, c.Id),
 		ErrorType: reporter_errors.ErrorCanonicalizedToRedirect,
 	}
 }
@@ -75,14 +77,39 @@ func (sr *SqlReporter) CanonicalizedToError(c *models.Crawl) *report_manager.Mul
 		SELECT
 			pr.id
 		FROM pagereports AS pr
-		INNER JOIN pagereports AS pr2 ON pr.canonical = pr2.url
+		INNER JOIN pagereports AS pr2 ON pr.canonical_hash = pr2.url_hash
 		WHERE pr.crawl_id = ?
 			AND pr2.crawl_id = ?
 			AND pr.canonical != pr.url
 			AND pr2.status_code >= 400;`
 
 	return &report_manager.MultipageIssueReporter{
-		Pstream:   sr.pageReportsQuery(query, c.Id),
+		Pstream:   sr.pageReportsQuery(query, c.Id, c.Id),
 		ErrorType: reporter_errors.ErrorCanonicalizedToError,
 	}
 }
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages
+// that are part of a canonical chain, where the page is canonicalized to a page that is
+// canonicalized to a third URL (A→B→C). Only the first page of the chain (A) is reported
+// here, the page in the middle (B) is reported by CanonicalizedToNonCanonical.
+func (sr *SqlReporter) CanonicalChainReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT a.id
+		FROM pagereports AS a
+		INNER JOIN pagereports AS b ON a.canonical_hash = b.url_hash
+		WHERE a.crawl_id = ?
+			AND b.crawl_id = ?
+			AND a.canonical != ""
+			AND a.canonical != a.url
+			AND b.canonical != ""
+			AND b.canonical != b.url
+			AND a.crawled = 1
+			AND b.crawled = 1`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id, c.Id),
+		ErrorType: reporter_errors.ErrorCanonicalChain,
+	}
+}
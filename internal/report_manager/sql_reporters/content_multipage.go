@@ -0,0 +1,50 @@
+package sql_reporters
+
+import (
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+const (
+	// Pages with a readability score below this value are reported as difficult to read.
+	lowReadabilityScore = 30
+
+	// Minimum number of words for a page to be checked for low readability.
+	readabilityMinWords = 100
+)
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages with
+// duplicate or near duplicate main content. The pages are clustered by the duplicates service
+// once the crawl has ended, before the multipage issues are created.
+func (sr *SqlReporter) DuplicatedContentReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			id
+		FROM pagereports
+		WHERE crawl_id = ? AND duplicate_cluster > 0`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id),
+		ErrorType: reporter_errors.ErrorDuplicatedContent,
+	}
+}
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages with
+// a low readability score. The issue is only reported if the project has the readability check
+// enabled, and only pages with enough words to have a meaningful score are considered.
+func (sr *SqlReporter) LowReadabilityReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			pagereports.id
+		FROM pagereports
+		INNER JOIN projects ON projects.id = ? AND projects.check_readability = 1
+		WHERE pagereports.crawl_id = ? AND media_type = "text/html" AND status_code >= 200
+		AND status_code < 300 AND crawled = 1 AND valid_readability = 1
+		AND words >= ? AND readability < ?`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.ProjectId, c.Id, readabilityMinWords, lowReadabilityScore),
+		ErrorType: reporter_errors.ErrorLowReadability,
+	}
+}
@@ -112,3 +112,26 @@ func (sr *SqlReporter) HreflangToError(c *models.Crawl) *report_manager.Multipag
 		ErrorType: reporter_errors.ErrorHreflangToError,
 	}
 }
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages that have
+// hreflang links to pages whose language doesn't match the hreflang language.
+func (sr *SqlReporter) HreflangLangMismatch(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT hreflangs.pagereport_id
+		FROM hreflangs
+		INNER JOIN pagereports ON pagereports.url_hash = hreflangs.to_hash
+			AND pagereports.crawl_id = hreflangs.crawl_id
+		WHERE hreflangs.crawl_id = ?
+			AND hreflangs.to_lang != "x-default"
+			AND pagereports.lang != ""
+			AND pagereports.crawled = 1
+			AND pagereports.media_type = "text/html"
+			AND LOWER(SUBSTRING_INDEX(REPLACE(hreflangs.to_lang, "_", "-"), "-", 1))
+				!= LOWER(SUBSTRING_INDEX(REPLACE(pagereports.lang, "_", "-"), "-", 1))`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id),
+		ErrorType: reporter_errors.ErrorHreflangLangMismatch,
+	}
+}
@@ -0,0 +1,54 @@
+package sql_reporters
+
+import (
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages
+// with images larger than the maximum image size set in the project. The image size is the
+// size of the crawled image resource.
+func (sr *SqlReporter) OversizedImagesReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT pagereports.id
+		FROM pagereports
+		INNER JOIN projects ON projects.id = ?
+		INNER JOIN images ON images.pagereport_id = pagereports.id
+		INNER JOIN pagereports AS r ON r.url_hash = images.url_hash AND r.crawl_id = images.crawl_id
+		WHERE pagereports.crawl_id = ?
+			AND pagereports.crawled = 1
+			AND r.crawled = 1
+			AND r.status_code >= 200
+			AND r.status_code < 300
+			AND r.size > projects.max_image_size * 1024`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.ProjectId, c.Id),
+		ErrorType: reporter_errors.ErrorOversizedImages,
+	}
+}
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages
+// with images in formats such as JPEG, PNG or GIF instead of modern formats such as WebP or AVIF.
+// The format is the media type of the crawled image resource.
+func (sr *SqlReporter) NonModernImageFormatReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT pagereports.id
+		FROM pagereports
+		INNER JOIN images ON images.pagereport_id = pagereports.id
+		INNER JOIN pagereports AS r ON r.url_hash = images.url_hash AND r.crawl_id = images.crawl_id
+		WHERE pagereports.crawl_id = ?
+			AND pagereports.crawled = 1
+			AND r.crawled = 1
+			AND r.status_code >= 200
+			AND r.status_code < 300
+			AND r.media_type IN ("image/jpeg", "image/png", "image/gif", "image/bmp", "image/tiff")`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id),
+		ErrorType: reporter_errors.ErrorNonModernImageFormat,
+	}
+}
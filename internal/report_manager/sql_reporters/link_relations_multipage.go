@@ -0,0 +1,93 @@
+package sql_reporters
+
+import (
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages with broken
+// pagination. The pagination is broken if the next or prev link points to a page that doesn't return a
+// 2xx status code, or if the linked page doesn't link back with the opposite relation.
+func (sr *SqlReporter) BrokenPaginationReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT link_relations.pagereport_id
+		FROM link_relations
+		INNER JOIN pagereports ON pagereports.url_hash = link_relations.url_hash
+			AND pagereports.crawl_id = link_relations.crawl_id
+			AND pagereports.crawled = 1
+		LEFT JOIN link_relations b ON b.pagereport_id = pagereports.id
+			AND b.url_hash = link_relations.from_hash
+			AND b.rel = IF(link_relations.rel = "next", "prev", "next")
+		WHERE link_relations.crawl_id = ? AND link_relations.rel IN ("next", "prev")
+			AND (pagereports.status_code < 200 OR pagereports.status_code >= 300 OR b.id IS NULL)`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id),
+		ErrorType: reporter_errors.ErrorBrokenPagination,
+	}
+}
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for AMP pages
+// with a canonical URL that doesn't point back to the page linking to them with the amphtml relation.
+func (sr *SqlReporter) AmpCanonicalMismatchReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT pagereports.id
+		FROM link_relations
+		INNER JOIN pagereports ON pagereports.url_hash = link_relations.url_hash
+			AND pagereports.crawl_id = link_relations.crawl_id
+		WHERE link_relations.crawl_id = ? AND link_relations.rel = "amphtml"
+			AND pagereports.crawled = 1
+			AND pagereports.status_code >= 200 AND pagereports.status_code < 300
+			AND SHA2(pagereports.canonical, 256) != link_relations.from_hash`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id),
+		ErrorType: reporter_errors.ErrorAmpCanonicalMismatch,
+	}
+}
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages with
+// mobile alternate URLs, such as m-dot pages, whose canonical URL doesn't point back to them.
+func (sr *SqlReporter) MobileAlternateNotReciprocalReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT link_relations.pagereport_id
+		FROM link_relations
+		INNER JOIN pagereports ON pagereports.url_hash = link_relations.url_hash
+			AND pagereports.crawl_id = link_relations.crawl_id
+		WHERE link_relations.crawl_id = ? AND link_relations.rel = "alternate"
+			AND pagereports.crawled = 1
+			AND pagereports.status_code >= 200 AND pagereports.status_code < 300
+			AND SHA2(pagereports.canonical, 256) != link_relations.from_hash`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id),
+		ErrorType: reporter_errors.ErrorMobileAlternateNotReciprocal,
+	}
+}
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for paginated pages
+// canonicalized to the first page of the series. A page is considered part of a series if it has
+// a prev link, and the first page is the one that has a next link but no prev link.
+func (sr *SqlReporter) PaginationCanonicalFirstPageReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT pagereports.id
+		FROM pagereports
+		INNER JOIN link_relations AS prev ON prev.pagereport_id = pagereports.id AND prev.rel = "prev"
+		INNER JOIN pagereports AS first ON first.url_hash = SHA2(pagereports.canonical, 256)
+			AND first.crawl_id = pagereports.crawl_id
+		INNER JOIN link_relations AS next ON next.pagereport_id = first.id AND next.rel = "next"
+		LEFT JOIN link_relations AS first_prev ON first_prev.pagereport_id = first.id AND first_prev.rel = "prev"
+		WHERE pagereports.crawl_id = ? AND pagereports.crawled = 1
+			AND pagereports.canonical != "" AND pagereports.canonical != pagereports.url
+			AND first_prev.id IS NULL`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id),
+		ErrorType: reporter_errors.ErrorPaginationCanonicalFirstPage,
+	}
+}
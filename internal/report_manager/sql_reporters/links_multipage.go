@@ -53,3 +53,41 @@ func (sr *SqlReporter) FollowNoFollowReporter(c *models.Crawl) *report_manager.M
 		ErrorType: reporter_errors.ErrorIncomingFollowNofollow,
 	}
 }
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages
+// with external links returning errors with status codes in the 40x or 50x range, as well
+// as external links that could not be fetched.
+func (sr *SqlReporter) ExternalLinkBrokenReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT external_links.pagereport_id
+		FROM external_links
+		INNER JOIN external_link_status ON external_link_status.url_hash = external_links.url_hash
+			AND external_link_status.crawl_id = external_links.crawl_id
+		WHERE external_links.crawl_id = ?
+			AND (external_link_status.status_code >= 400 OR external_link_status.status_code = 0)`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id),
+		ErrorType: reporter_errors.ErrorExternalLinkBroken,
+	}
+}
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages
+// with external links that are redirected with status codes in the 30x range.
+func (sr *SqlReporter) ExternalLinkRedirectReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT external_links.pagereport_id
+		FROM external_links
+		INNER JOIN external_link_status ON external_link_status.url_hash = external_links.url_hash
+			AND external_link_status.crawl_id = external_links.crawl_id
+		WHERE external_links.crawl_id = ?
+			AND external_link_status.status_code >= 300
+			AND external_link_status.status_code < 400`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id),
+		ErrorType: reporter_errors.ErrorExternalLinkRedirect,
+	}
+}
@@ -0,0 +1,37 @@
+package sql_reporters
+
+import (
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for PDF documents
+// with internal or external links returning errors with status codes in the 40x or 50x range,
+// as well as links that timed out.
+func (sr *SqlReporter) PDFBrokenLinksReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT pagereports.id
+		FROM pagereports
+		INNER JOIN (
+			SELECT links.pagereport_id
+			FROM links
+			INNER JOIN pagereports AS l ON l.url_hash = links.url_hash AND l.crawl_id = links.crawl_id
+			WHERE links.crawl_id = ? AND l.status_code >= 400
+			UNION ALL
+			SELECT external_links.pagereport_id
+			FROM external_links
+			INNER JOIN external_link_status ON external_link_status.url_hash = external_links.url_hash
+				AND external_link_status.crawl_id = external_links.crawl_id
+			WHERE external_links.crawl_id = ?
+				AND (external_link_status.status_code >= 400 OR external_link_status.status_code = 0)
+		) AS broken ON broken.pagereport_id = pagereports.id
+		WHERE pagereports.crawl_id = ?
+			AND pagereports.media_type = "application/pdf"`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id, c.Id, c.Id),
+		ErrorType: reporter_errors.ErrorPDFBrokenLinks,
+	}
+}
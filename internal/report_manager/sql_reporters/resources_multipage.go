@@ -0,0 +1,58 @@
+package sql_reporters
+
+import (
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages
+// that have images, scripts or styles returning errors with status codes in the 40x or 50x range.
+func (sr *SqlReporter) BrokenResourcesReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT pagereports.id
+		FROM pagereports
+		INNER JOIN (
+			SELECT pagereport_id, url_hash FROM images WHERE crawl_id = ?
+			UNION ALL
+			SELECT pagereport_id, url_hash FROM scripts WHERE crawl_id = ?
+			UNION ALL
+			SELECT pagereport_id, url_hash FROM styles WHERE crawl_id = ?
+		) AS resources ON resources.pagereport_id = pagereports.id
+		INNER JOIN pagereports AS r ON r.url_hash = resources.url_hash AND r.crawl_id = ?
+		WHERE pagereports.crawl_id = ?
+			AND pagereports.crawled = 1
+			AND r.status_code >= 400`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id, c.Id, c.Id, c.Id, c.Id),
+		ErrorType: reporter_errors.ErrorBrokenResources,
+	}
+}
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages
+// that have images, scripts or styles that are redirected with status codes in the 30x range.
+func (sr *SqlReporter) RedirectedResourcesReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT pagereports.id
+		FROM pagereports
+		INNER JOIN (
+			SELECT pagereport_id, url_hash FROM images WHERE crawl_id = ?
+			UNION ALL
+			SELECT pagereport_id, url_hash FROM scripts WHERE crawl_id = ?
+			UNION ALL
+			SELECT pagereport_id, url_hash FROM styles WHERE crawl_id = ?
+		) AS resources ON resources.pagereport_id = pagereports.id
+		INNER JOIN pagereports AS r ON r.url_hash = resources.url_hash AND r.crawl_id = ?
+		WHERE pagereports.crawl_id = ?
+			AND pagereports.crawled = 1
+			AND r.status_code >= 300
+			AND r.status_code < 400`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id, c.Id, c.Id, c.Id, c.Id),
+		ErrorType: reporter_errors.ErrorRedirectedResources,
+	}
+}
@@ -0,0 +1,26 @@
+package sql_reporters
+
+import (
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/report_manager"
+	"github.com/stjudewashere/seonaut/internal/report_manager/reporter_errors"
+)
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages
+// with an og:image URL that returns a 4xx or 5xx error.
+func (sr *SqlReporter) BrokenOGImageReporter(c *models.Crawl) *report_manager.MultipageIssueReporter {
+	query := `
+		SELECT
+			DISTINCT social_tags.pagereport_id
+		FROM social_tags
+		INNER JOIN pagereports ON pagereports.url_hash = social_tags.og_image_hash
+			AND pagereports.crawl_id = social_tags.crawl_id
+		WHERE social_tags.crawl_id = ?
+			AND social_tags.og_image_url != ""
+			AND pagereports.status_code >= 400`
+
+	return &report_manager.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id),
+		ErrorType: reporter_errors.ErrorOGImageBroken,
+	}
+}
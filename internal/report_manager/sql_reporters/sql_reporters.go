@@ -31,19 +31,58 @@ func (sr *SqlReporter) GetAllReporters() []report_manager.MultipageCallback {
 		// Add description issue reporters
 		sr.DuplicatedDescriptionReporter,
 
+		// Add content issue reporters
+		sr.LowReadabilityReporter,
+
 		// Add link issue reporters
 		sr.OrphanPagesReporter,
 		sr.NoFollowIndexableReporter,
 		sr.FollowNoFollowReporter,
+		sr.ExternalLinkBrokenReporter,
+		sr.ExternalLinkRedirectReporter,
 
 		// Add hreflang reporters
 		sr.MissingHrelangReturnLinks,
 		sr.HreflangsToNonCanonical,
 		sr.HreflangNoindexable,
+		sr.HreflangLangMismatch,
 
 		// Add canonical issue reporters
 		sr.CanonicalizedToNonCanonical,
 		sr.CanonicalizedToNonIndexable,
+		sr.CanonicalChainReporter,
+
+		// Add link relation issue reporters
+		sr.BrokenPaginationReporter,
+		sr.AmpCanonicalMismatchReporter,
+		sr.MobileAlternateNotReciprocalReporter,
+		sr.PaginationCanonicalFirstPageReporter,
+
+		// Add resource issue reporters
+		sr.BrokenResourcesReporter,
+		sr.RedirectedResourcesReporter,
+
+		// Add image issue reporters
+		sr.NonModernImageFormatReporter,
+
+		// Add social tags issue reporters
+		sr.BrokenOGImageReporter,
+
+		// Add PDF issue reporters
+		sr.PDFBrokenLinksReporter,
+	}
+}
+
+// GetThresholdReporters returns a slice of the reporters in the SqlReporter that depend on the
+// project's thresholds. Their issues are re-evaluated when the thresholds change.
+func (sr *SqlReporter) GetThresholdReporters() []report_manager.MultipageCallback {
+	return []report_manager.MultipageCallback{
+		// Uses the DuplicateThreshold through the duplicate clusters,
+		// which must be built again before this reporter is run.
+		sr.DuplicatedContentReporter,
+
+		// Uses the MaxImageSize
+		sr.OversizedImagesReporter,
 	}
 }
 
@@ -58,7 +97,9 @@ func (sr *SqlReporter) pageReportsQuery(query string, args ...interface{}) <-cha
 		rows, err := sr.db.Query(query, args...)
 		if err != nil {
 			log.Println(err)
+			return
 		}
+		defer rows.Close()
 
 		for rows.Next() {
 			var pid int64
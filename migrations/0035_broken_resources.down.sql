@@ -0,0 +1,7 @@
+DROP INDEX images_hash ON images;
+DROP INDEX scripts_hash ON scripts;
+DROP INDEX styles_hash ON styles;
+
+ALTER TABLE `images` DROP COLUMN `url_hash`;
+ALTER TABLE `scripts` DROP COLUMN `url_hash`;
+ALTER TABLE `styles` DROP COLUMN `url_hash`;
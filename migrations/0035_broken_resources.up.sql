@@ -0,0 +1,11 @@
+ALTER TABLE `images` ADD COLUMN `url_hash` varchar(256) NOT NULL DEFAULT '';
+ALTER TABLE `scripts` ADD COLUMN `url_hash` varchar(256) NOT NULL DEFAULT '';
+ALTER TABLE `styles` ADD COLUMN `url_hash` varchar(256) NOT NULL DEFAULT '';
+
+UPDATE images SET url_hash = SHA2(url, 256);
+UPDATE scripts SET url_hash = SHA2(url, 256);
+UPDATE styles SET url_hash = SHA2(url, 256);
+
+CREATE INDEX images_hash ON images(crawl_id, url_hash);
+CREATE INDEX scripts_hash ON scripts(crawl_id, url_hash);
+CREATE INDEX styles_hash ON styles(crawl_id, url_hash);
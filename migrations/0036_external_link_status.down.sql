@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS `external_link_status`;
+
+DROP INDEX external_links_hash ON external_links;
+ALTER TABLE `external_links` DROP COLUMN `url_hash`;
+
+ALTER TABLE `projects` DROP COLUMN `check_external_links`;
@@ -0,0 +1,16 @@
+ALTER TABLE `projects` ADD COLUMN `check_external_links` tinyint NOT NULL DEFAULT '0';
+
+ALTER TABLE `external_links` ADD COLUMN `url_hash` varchar(256) NOT NULL DEFAULT '';
+UPDATE external_links SET url_hash = SHA2(url, 256);
+CREATE INDEX external_links_hash ON external_links(crawl_id, url_hash);
+
+CREATE TABLE IF NOT EXISTS `external_link_status` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `crawl_id` int unsigned NOT NULL,
+  `url` varchar(2048) NOT NULL DEFAULT '',
+  `url_hash` varchar(256) NOT NULL DEFAULT '',
+  `status_code` int NOT NULL DEFAULT '0',
+  PRIMARY KEY (`id`),
+  KEY `external_link_status_hash` (`crawl_id`, `url_hash`),
+  CONSTRAINT `external_link_status_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE
+);
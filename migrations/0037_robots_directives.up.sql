@@ -0,0 +1 @@
+ALTER TABLE `pagereports` MODIFY COLUMN `robots` varchar(512) DEFAULT NULL;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `structured_data` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `format` varchar(16) NOT NULL DEFAULT '',
+  `type` varchar(256) NOT NULL DEFAULT '',
+  `properties` text,
+  `valid` tinyint NOT NULL DEFAULT '1',
+  PRIMARY KEY (`id`),
+  KEY `structured_data_pagereport` (`pagereport_id`),
+  KEY `structured_data_crawl` (`crawl_id`),
+  CONSTRAINT `structured_data_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `structured_data_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS `social_tags` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `og_title` varchar(2048) NOT NULL DEFAULT '',
+  `og_description` varchar(2048) NOT NULL DEFAULT '',
+  `og_image` varchar(2048) NOT NULL DEFAULT '',
+  `og_image_url` varchar(2048) NOT NULL DEFAULT '',
+  `og_image_hash` varchar(256) NOT NULL DEFAULT '',
+  `og_url` varchar(2048) NOT NULL DEFAULT '',
+  `og_type` varchar(256) NOT NULL DEFAULT '',
+  `og_site_name` varchar(2048) NOT NULL DEFAULT '',
+  `twitter_card` varchar(256) NOT NULL DEFAULT '',
+  `twitter_title` varchar(2048) NOT NULL DEFAULT '',
+  `twitter_description` varchar(2048) NOT NULL DEFAULT '',
+  `twitter_image` varchar(2048) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `social_tags_pagereport` (`pagereport_id`),
+  KEY `social_tags_hash` (`crawl_id`, `og_image_hash`),
+  CONSTRAINT `social_tags_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `social_tags_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `headings` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `level` tinyint NOT NULL DEFAULT '0',
+  `text` varchar(2048) NOT NULL DEFAULT '',
+  `skips_level` tinyint NOT NULL DEFAULT '0',
+  PRIMARY KEY (`id`),
+  KEY `headings_pagereport` (`pagereport_id`),
+  KEY `headings_crawl` (`crawl_id`),
+  CONSTRAINT `headings_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `headings_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS `duplicate_canonicals`;
+
+ALTER TABLE `projects` DROP COLUMN `duplicate_threshold`;
+
+DROP INDEX pagereports_content_hash ON pagereports;
+ALTER TABLE `pagereports` DROP COLUMN `simhash`;
+ALTER TABLE `pagereports` DROP COLUMN `content_hash`;
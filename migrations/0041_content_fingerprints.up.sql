@@ -0,0 +1,15 @@
+ALTER TABLE `pagereports` ADD COLUMN `content_hash` varchar(64) NOT NULL DEFAULT '';
+ALTER TABLE `pagereports` ADD COLUMN `simhash` bigint unsigned NOT NULL DEFAULT '0';
+CREATE INDEX pagereports_content_hash ON pagereports(crawl_id, content_hash);
+
+ALTER TABLE `projects` ADD COLUMN `duplicate_threshold` tinyint unsigned NOT NULL DEFAULT '90';
+
+CREATE TABLE IF NOT EXISTS `duplicate_canonicals` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `duplicate_canonicals_crawl` (`crawl_id`),
+  CONSTRAINT `duplicate_canonicals_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `duplicate_canonicals_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,7 @@
+ALTER TABLE `projects` DROP COLUMN `check_readability`;
+
+ALTER TABLE `pagereports` DROP COLUMN `valid_readability`;
+ALTER TABLE `pagereports` DROP COLUMN `readability`;
+ALTER TABLE `pagereports` DROP COLUMN `avg_sentence_length`;
+ALTER TABLE `pagereports` DROP COLUMN `sentences`;
+ALTER TABLE `pagereports` DROP COLUMN `text_ratio`;
@@ -0,0 +1,7 @@
+ALTER TABLE `pagereports` ADD COLUMN `text_ratio` float NOT NULL DEFAULT '0';
+ALTER TABLE `pagereports` ADD COLUMN `sentences` int NOT NULL DEFAULT '0';
+ALTER TABLE `pagereports` ADD COLUMN `avg_sentence_length` float NOT NULL DEFAULT '0';
+ALTER TABLE `pagereports` ADD COLUMN `readability` float NOT NULL DEFAULT '0';
+ALTER TABLE `pagereports` ADD COLUMN `valid_readability` tinyint NOT NULL DEFAULT '0';
+
+ALTER TABLE `projects` ADD COLUMN `check_readability` tinyint NOT NULL DEFAULT '0';
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS `extractors` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `project_id` int unsigned NOT NULL,
+  `name` varchar(128) NOT NULL DEFAULT '',
+  `type` varchar(16) NOT NULL DEFAULT '',
+  `expression` varchar(1024) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `extractors_project` (`project_id`),
+  CONSTRAINT `extractors_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `extractions` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `name` varchar(128) NOT NULL DEFAULT '',
+  `value` varchar(2048) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `extractions_pagereport` (`pagereport_id`),
+  KEY `extractions_crawl` (`crawl_id`),
+  CONSTRAINT `extractions_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `extractions_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
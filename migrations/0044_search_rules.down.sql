@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `custom_issues`;
+DROP TABLE IF EXISTS `search_rules`;
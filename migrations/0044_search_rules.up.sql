@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS `search_rules` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `project_id` int unsigned NOT NULL,
+  `name` varchar(128) NOT NULL DEFAULT '',
+  `pattern` varchar(1024) NOT NULL DEFAULT '',
+  `type` varchar(16) NOT NULL DEFAULT '',
+  `mode` varchar(16) NOT NULL DEFAULT '',
+  `target` varchar(16) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `search_rules_project` (`project_id`),
+  CONSTRAINT `search_rules_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `custom_issues` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `name` varchar(128) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `custom_issues_pagereport` (`pagereport_id`),
+  KEY `custom_issues_crawl_name` (`crawl_id`, `name`),
+  CONSTRAINT `custom_issues_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `custom_issues_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,10 @@
+ALTER TABLE `external_links` DROP COLUMN `image`;
+ALTER TABLE `external_links` DROP COLUMN `blank_noopener`;
+ALTER TABLE `external_links` DROP COLUMN `title`;
+ALTER TABLE `external_links` DROP COLUMN `position`;
+
+ALTER TABLE `links` DROP INDEX `links_hash_position`;
+ALTER TABLE `links` DROP COLUMN `image`;
+ALTER TABLE `links` DROP COLUMN `blank_noopener`;
+ALTER TABLE `links` DROP COLUMN `title`;
+ALTER TABLE `links` DROP COLUMN `position`;
@@ -0,0 +1,10 @@
+ALTER TABLE `links` ADD COLUMN `position` varchar(16) NOT NULL DEFAULT '';
+ALTER TABLE `links` ADD COLUMN `title` varchar(1024) NOT NULL DEFAULT '';
+ALTER TABLE `links` ADD COLUMN `blank_noopener` tinyint NOT NULL DEFAULT '0';
+ALTER TABLE `links` ADD COLUMN `image` tinyint NOT NULL DEFAULT '0';
+ALTER TABLE `links` ADD INDEX `links_hash_position` (`url_hash`, `position`);
+
+ALTER TABLE `external_links` ADD COLUMN `position` varchar(16) NOT NULL DEFAULT '';
+ALTER TABLE `external_links` ADD COLUMN `title` varchar(1024) NOT NULL DEFAULT '';
+ALTER TABLE `external_links` ADD COLUMN `blank_noopener` tinyint NOT NULL DEFAULT '0';
+ALTER TABLE `external_links` ADD COLUMN `image` tinyint NOT NULL DEFAULT '0';
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `link_relations` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `rel` varchar(16) NOT NULL DEFAULT '',
+  `url` varchar(2048) NOT NULL DEFAULT '',
+  `media` varchar(256) NOT NULL DEFAULT '',
+  `from_hash` varchar(256) NOT NULL DEFAULT '',
+  `url_hash` varchar(256) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `link_relations_pagereport` (`pagereport_id`),
+  KEY `link_relations_crawl_rel` (`crawl_id`, `rel`),
+  KEY `link_relations_url_hash` (`url_hash`),
+  CONSTRAINT `link_relations_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `link_relations_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,7 @@
+ALTER TABLE `projects` DROP COLUMN `max_image_size`;
+
+ALTER TABLE `images` DROP COLUMN `srcset`;
+ALTER TABLE `images` DROP COLUMN `decoding`;
+ALTER TABLE `images` DROP COLUMN `loading`;
+ALTER TABLE `images` DROP COLUMN `height`;
+ALTER TABLE `images` DROP COLUMN `width`;
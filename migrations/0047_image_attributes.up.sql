@@ -0,0 +1,7 @@
+ALTER TABLE `images` ADD COLUMN `width` varchar(16) NOT NULL DEFAULT '';
+ALTER TABLE `images` ADD COLUMN `height` varchar(16) NOT NULL DEFAULT '';
+ALTER TABLE `images` ADD COLUMN `loading` varchar(16) NOT NULL DEFAULT '';
+ALTER TABLE `images` ADD COLUMN `decoding` varchar(16) NOT NULL DEFAULT '';
+ALTER TABLE `images` ADD COLUMN `srcset` tinyint NOT NULL DEFAULT '0';
+
+ALTER TABLE `projects` ADD COLUMN `max_image_size` int unsigned NOT NULL DEFAULT '100';
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `page_setup` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `viewport` varchar(1024) NOT NULL DEFAULT '',
+  `meta_charset` varchar(64) NOT NULL DEFAULT '',
+  `header_charset` varchar(64) NOT NULL DEFAULT '',
+  `favicon` varchar(2048) NOT NULL DEFAULT '',
+  `apple_touch_icon` varchar(2048) NOT NULL DEFAULT '',
+  `theme_color` varchar(64) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `page_setup_pagereport` (`pagereport_id`),
+  KEY `page_setup_crawl` (`crawl_id`),
+  CONSTRAINT `page_setup_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `page_setup_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
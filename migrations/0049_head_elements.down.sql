@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `head_elements`;
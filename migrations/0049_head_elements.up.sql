@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS `head_elements` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `breaking_element` varchar(64) NOT NULL DEFAULT '',
+  `tags_after_break` varchar(256) NOT NULL DEFAULT '',
+  `duplicate_ids` text NOT NULL,
+  `titles` int unsigned NOT NULL DEFAULT 0,
+  `canonicals` int unsigned NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  KEY `head_elements_pagereport` (`pagereport_id`),
+  KEY `head_elements_crawl` (`crawl_id`),
+  CONSTRAINT `head_elements_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `head_elements_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+ALTER TABLE `crawls` DROP COLUMN `accessibility_issues`;
+
+DROP TABLE IF EXISTS `accessibility`;
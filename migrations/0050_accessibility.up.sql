@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS `accessibility` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `inputs_without_label` int unsigned NOT NULL DEFAULT 0,
+  `buttons_without_name` int unsigned NOT NULL DEFAULT 0,
+  `links_without_name` int unsigned NOT NULL DEFAULT 0,
+  `positive_tabindex` int unsigned NOT NULL DEFAULT 0,
+  `iframes_without_title` int unsigned NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  KEY `accessibility_pagereport` (`pagereport_id`),
+  KEY `accessibility_crawl` (`crawl_id`),
+  CONSTRAINT `accessibility_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `accessibility_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
+
+ALTER TABLE `crawls` ADD COLUMN `accessibility_issues` int NOT NULL DEFAULT '0';
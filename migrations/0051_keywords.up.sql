@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `keywords` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `term` varchar(256) NOT NULL DEFAULT '',
+  `words` tinyint unsigned NOT NULL DEFAULT 1,
+  `count` int unsigned NOT NULL DEFAULT 0,
+  `in_title` tinyint NOT NULL DEFAULT 0,
+  `in_h1` tinyint NOT NULL DEFAULT 0,
+  `is_primary` tinyint NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  KEY `keywords_pagereport` (`pagereport_id`),
+  KEY `keywords_crawl_term` (`crawl_id`, `term`),
+  CONSTRAINT `keywords_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `keywords_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS `pdf_documents` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `pages` int unsigned NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  KEY `pdf_documents_pagereport` (`pagereport_id`),
+  KEY `pdf_documents_crawl` (`crawl_id`),
+  CONSTRAINT `pdf_documents_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `pdf_documents_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
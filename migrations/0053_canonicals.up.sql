@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `canonicals` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `url` varchar(2048) NOT NULL,
+  `source` varchar(16) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `canonicals_pagereport` (`pagereport_id`),
+  KEY `canonicals_crawl` (`crawl_id`),
+  CONSTRAINT `canonicals_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `canonicals_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+-- The hreflang validation issue types are created from the issue types registry on startup.
+DO 0;
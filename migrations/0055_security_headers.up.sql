@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `security_headers` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `strict_transport_security` text NOT NULL,
+  `content_security_policy` text NOT NULL,
+  `x_frame_options` text NOT NULL,
+  `x_content_type_options` text NOT NULL,
+  `referrer_policy` text NOT NULL,
+  `permissions_policy` text NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `security_headers_pagereport` (`pagereport_id`),
+  KEY `security_headers_crawl` (`crawl_id`),
+  CONSTRAINT `security_headers_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `security_headers_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
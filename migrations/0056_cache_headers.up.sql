@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS `cache_headers` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `cache_control` text NOT NULL,
+  `expires` text NOT NULL,
+  `age` text NOT NULL,
+  `etag` text NOT NULL,
+  `last_modified` text NOT NULL,
+  `set_cookie` tinyint NOT NULL DEFAULT '0',
+  `max_age` bigint NOT NULL DEFAULT '-1',
+  PRIMARY KEY (`id`),
+  KEY `cache_headers_pagereport` (`pagereport_id`),
+  KEY `cache_headers_crawl` (`crawl_id`),
+  CONSTRAINT `cache_headers_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `cache_headers_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
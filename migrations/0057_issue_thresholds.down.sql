@@ -0,0 +1,6 @@
+ALTER TABLE `projects` DROP COLUMN `title_min_length`;
+ALTER TABLE `projects` DROP COLUMN `title_max_length`;
+ALTER TABLE `projects` DROP COLUMN `description_min_length`;
+ALTER TABLE `projects` DROP COLUMN `description_max_length`;
+ALTER TABLE `projects` DROP COLUMN `max_links`;
+ALTER TABLE `projects` DROP COLUMN `min_words`;
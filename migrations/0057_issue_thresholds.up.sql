@@ -0,0 +1,6 @@
+ALTER TABLE `projects` ADD COLUMN `title_min_length` smallint unsigned NOT NULL DEFAULT '20';
+ALTER TABLE `projects` ADD COLUMN `title_max_length` smallint unsigned NOT NULL DEFAULT '60';
+ALTER TABLE `projects` ADD COLUMN `description_min_length` smallint unsigned NOT NULL DEFAULT '80';
+ALTER TABLE `projects` ADD COLUMN `description_max_length` smallint unsigned NOT NULL DEFAULT '160';
+ALTER TABLE `projects` ADD COLUMN `max_links` int unsigned NOT NULL DEFAULT '100';
+ALTER TABLE `projects` ADD COLUMN `min_words` int unsigned NOT NULL DEFAULT '200';
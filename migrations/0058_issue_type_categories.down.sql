@@ -0,0 +1 @@
+ALTER TABLE `issue_types` DROP COLUMN `category`;
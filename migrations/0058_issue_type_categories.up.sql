@@ -0,0 +1 @@
+ALTER TABLE `issue_types` ADD COLUMN `category` varchar(64) NOT NULL DEFAULT '';
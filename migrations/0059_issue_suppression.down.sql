@@ -0,0 +1,3 @@
+ALTER TABLE `issues` DROP COLUMN `suppressed`;
+
+DROP TABLE IF EXISTS `suppression_rules`;
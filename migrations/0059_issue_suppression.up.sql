@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS `suppression_rules` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `project_id` int unsigned NOT NULL,
+  `issue_type` varchar(256) NOT NULL DEFAULT '',
+  `url_pattern` varchar(1024) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `suppression_rules_project` (`project_id`),
+  CONSTRAINT `suppression_rules_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE
+);
+
+ALTER TABLE `issues` ADD COLUMN `suppressed` tinyint NOT NULL DEFAULT '0';
@@ -0,0 +1,2 @@
+ALTER TABLE `images` ADD COLUMN `loading` varchar(16) NOT NULL DEFAULT '';
+ALTER TABLE `images` ADD COLUMN `decoding` varchar(16) NOT NULL DEFAULT '';
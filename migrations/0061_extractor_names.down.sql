@@ -0,0 +1 @@
+ALTER TABLE `extractors` DROP INDEX `extractors_project_name`;
@@ -0,0 +1,4 @@
+DELETE e FROM `extractors` e
+INNER JOIN `extractors` d ON d.project_id = e.project_id AND d.name = e.name AND d.id < e.id;
+
+ALTER TABLE `extractors` ADD UNIQUE KEY `extractors_project_name` (`project_id`, `name`);
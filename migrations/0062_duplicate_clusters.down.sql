@@ -0,0 +1,2 @@
+DROP INDEX pagereports_duplicate_cluster ON pagereports;
+ALTER TABLE `pagereports` DROP COLUMN `duplicate_cluster`;
@@ -0,0 +1,4 @@
+ALTER TABLE `pagereports` ADD COLUMN `duplicate_cluster` int unsigned NOT NULL DEFAULT '0';
+CREATE INDEX pagereports_duplicate_cluster ON pagereports(crawl_id, duplicate_cluster);
+
+UPDATE `projects` SET `duplicate_threshold` = 80 WHERE `duplicate_threshold` < 80;
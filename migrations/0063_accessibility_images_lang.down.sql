@@ -0,0 +1,2 @@
+ALTER TABLE `accessibility` DROP COLUMN `missing_lang`;
+ALTER TABLE `accessibility` DROP COLUMN `images_without_alt`;
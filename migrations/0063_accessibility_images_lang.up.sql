@@ -0,0 +1,2 @@
+ALTER TABLE `accessibility` ADD COLUMN `images_without_alt` int unsigned NOT NULL DEFAULT 0;
+ALTER TABLE `accessibility` ADD COLUMN `missing_lang` tinyint NOT NULL DEFAULT 0;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `site_keywords` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `crawl_id` int unsigned NOT NULL,
+  `term` varchar(256) NOT NULL DEFAULT '',
+  `words` tinyint unsigned NOT NULL DEFAULT 1,
+  `count` int unsigned NOT NULL DEFAULT 0,
+  `pages` int unsigned NOT NULL DEFAULT 0,
+  `titles` int unsigned NOT NULL DEFAULT 0,
+  `h1s` int unsigned NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  KEY `site_keywords_crawl_words` (`crawl_id`, `words`),
+  CONSTRAINT `site_keywords_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+DROP INDEX pagereports_canonical_hash ON pagereports;
+ALTER TABLE `pagereports` DROP COLUMN `canonical_hash`;
@@ -0,0 +1,4 @@
+ALTER TABLE `pagereports` ADD COLUMN `canonical_hash` varchar(256) DEFAULT NULL;
+CREATE INDEX pagereports_canonical_hash ON pagereports(canonical_hash);
+
+UPDATE `pagereports` SET `canonical_hash` = SHA2(`canonical`, 256) WHERE `canonical` != '';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `robots_directives`;
+ALTER TABLE `pagereports` DROP COLUMN `robots_conflict`;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `robots_directives` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned NOT NULL,
+  `source` varchar(16) NOT NULL,
+  `bot` varchar(256) NOT NULL DEFAULT '',
+  `name` varchar(256) NOT NULL,
+  `value` varchar(2048) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `robots_directives_pagereport` (`pagereport_id`),
+  KEY `robots_directives_crawl` (`crawl_id`),
+  CONSTRAINT `robots_directives_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `robots_directives_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
+
+ALTER TABLE `pagereports` ADD COLUMN `robots_conflict` tinyint NOT NULL DEFAULT '0';